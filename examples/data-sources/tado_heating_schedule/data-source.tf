@@ -0,0 +1,31 @@
+data "tado_heating_schedule" "living_room" {
+  home_name = "My Home"
+  zone_name = "Living Room"
+}
+
+# The following example shows how to copy the schedule of one zone to another
+# zone, regardless of the timetable used in the source zone.
+
+resource "tado_heating_schedule" "dining_room" {
+  home_name = "My Home"
+  zone_name = "Dining Room"
+
+  mon_sun = data.tado_heating_schedule.living_room.mon_sun
+  mon_fri = data.tado_heating_schedule.living_room.mon_fri
+  mon     = data.tado_heating_schedule.living_room.mon
+  tue     = data.tado_heating_schedule.living_room.tue
+  wed     = data.tado_heating_schedule.living_room.wed
+  thu     = data.tado_heating_schedule.living_room.thu
+  fri     = data.tado_heating_schedule.living_room.fri
+  sat     = data.tado_heating_schedule.living_room.sat
+  sun     = data.tado_heating_schedule.living_room.sun
+}
+
+# Using 'days', the schedule can be copied without caring about the timetable.
+
+resource "tado_heating_schedule" "hallway" {
+  home_name = "My Home"
+  zone_name = "Hallway"
+
+  days = data.tado_heating_schedule.living_room.days
+}
@@ -0,0 +1,11 @@
+data "tado_home_state" "home" {
+  home = "My Home"
+}
+
+output "presence" {
+  value = data.tado_home_state.home.presence
+}
+
+output "mobile_devices_at_home" {
+  value = data.tado_home_state.home.mobile_devices_at_home
+}
@@ -0,0 +1,16 @@
+# The following example shows how to heat an office during the weekly
+# recurring events of a calendar export.
+
+data "tado_ical_schedule" "office" {
+  path                = "${path.module}/office.ics"
+  comfort_temperature = 21.0
+  eco_temperature     = 17.0
+  time_zone           = "Europe/Berlin"
+}
+
+resource "tado_heating_schedule" "office" {
+  home_name = "My Home"
+  zone_name = "Office"
+
+  days = data.tado_ical_schedule.office.days
+}
@@ -0,0 +1,20 @@
+# The following example shows how to manage the heating schedules of all zones
+# listed in a CSV file like this:
+#
+# zone,day,start,end,heating,temperature
+# Kitchen,mon_sun,00:00,06:00,false,
+# Kitchen,mon_sun,06:00,22:00,true,20.5
+# Kitchen,mon_sun,22:00,00:00,false,
+
+data "tado_schedule_file" "rooms" {
+  path = "${path.module}/schedules.csv"
+}
+
+resource "tado_heating_schedule" "rooms" {
+  for_each = data.tado_schedule_file.rooms.zones
+
+  home_name = "My Home"
+  zone_name = each.key
+
+  days = each.value
+}
@@ -0,0 +1,16 @@
+# The following example shows how to get the setpoint of every 15-minute slot
+# of the week of a zone.
+
+data "tado_schedule_timeline" "living_room" {
+  home_name = "My Home"
+  zone_name = "Living Room"
+}
+
+# The following example shows how to expand time blocks which are not applied
+# to a zone yet.
+
+data "tado_schedule_timeline" "draft" {
+  interval = 60
+
+  mon_sun = provider::tado::schedule("06:00-09:00@20.5, 17:00-22:00@21")
+}
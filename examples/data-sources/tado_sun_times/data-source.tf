@@ -0,0 +1,25 @@
+# The following example shows how to heat the hallway from civil dawn until
+# sunrise, and again from sunset until civil dusk.
+
+data "tado_sun_times" "today" {
+  home_name = "My Home"
+}
+
+resource "tado_heating_schedule" "hallway" {
+  home_name = "My Home"
+  zone_name = "Hallway"
+
+  mon_sun = provider::tado::schedule(join(", ", [
+    "${data.tado_sun_times.today.civil_dawn}-${data.tado_sun_times.today.sunrise}@20",
+    "${data.tado_sun_times.today.sunset}-${data.tado_sun_times.today.civil_dusk}@20",
+  ]))
+}
+
+# The following example shows how to calculate the times offline for a date.
+
+data "tado_sun_times" "midsummer" {
+  geolocation_lat  = 52.52
+  geolocation_long = 13.405
+  time_zone        = "Europe/Berlin"
+  date             = "2024-06-21"
+}
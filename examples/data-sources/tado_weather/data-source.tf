@@ -0,0 +1,29 @@
+data "tado_weather" "home" {
+  home = "My Home"
+}
+
+output "outside_temperature" {
+  value = data.tado_weather.home.outside_temperature
+}
+
+# The following example shows how to warn when the heating in a zone is
+# scheduled to be off all day while it is freezing outside.
+
+resource "tado_heating_schedule" "garage" {
+  home_name = "My Home"
+  zone_name = "Garage"
+
+  mon_sun = [
+    { heating = false, start = "00:00", end = "00:00" },
+  ]
+}
+
+check "garage_frost_protection" {
+  assert {
+    condition = (
+      data.tado_weather.home.outside_temperature > 0 ||
+      anytrue([for block in tado_heating_schedule.garage.mon_sun : block.heating])
+    )
+    error_message = "The heating in the garage is scheduled to be off while it is freezing outside."
+  }
+}
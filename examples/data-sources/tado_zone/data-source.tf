@@ -2,3 +2,9 @@ data "tado_zone" "living_room" {
   name = "Living Room"
   home = "My Home"
 }
+
+# Zones can also be looked up by their ID.
+data "tado_zone" "kitchen" {
+  id      = 3
+  home_id = 123456
+}
@@ -0,0 +1,8 @@
+data "tado_zone_capabilities" "living_room" {
+  zone = "Living Room"
+  home = "My Home"
+}
+
+output "living_room_temperature_range" {
+  value = "${data.tado_zone_capabilities.living_room.celsius_min} - ${data.tado_zone_capabilities.living_room.celsius_max}"
+}
@@ -0,0 +1,18 @@
+data "tado_zone_state" "living_room" {
+  zone = "Living Room"
+  home = "My Home"
+}
+
+output "living_room_temperature" {
+  value = data.tado_zone_state.living_room.inside_temperature
+}
+
+# The zone state can be used in check blocks to verify the conditions in a
+# room after applying a configuration.
+
+check "living_room_humidity" {
+  assert {
+    condition     = data.tado_zone_state.living_room.humidity < 70
+    error_message = "Humidity in the living room is above 70%."
+  }
+}
@@ -0,0 +1,11 @@
+# The following example shows how to write the time blocks of a heating
+# schedule compactly. Heating is turned off outside of the given blocks.
+
+resource "tado_heating_schedule" "living_room" {
+  home_name = "My Home"
+  zone_name = "Living Room"
+
+  mon_fri = provider::tado::schedule("06:00-09:00@20.5, 17:00-22:00@21")
+  sat     = provider::tado::schedule("08:00-23:00@20.5")
+  sun     = provider::tado::schedule("08:00-23:00@20.5")
+}
@@ -0,0 +1,6 @@
+# The following example shows how to output the temperature a schedule sets
+# at 07:00.
+
+output "morning_temperature" {
+  value = provider::tado::schedule_at("06:00-09:00@20.5, 17:00-22:00@21", "07:00")
+}
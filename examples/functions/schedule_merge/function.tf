@@ -0,0 +1,13 @@
+# The following example shows how to add a lunch break and a warmer evening
+# to a template schedule.
+
+locals {
+  template = provider::tado::schedule("06:00-22:00@20")
+}
+
+resource "tado_heating_schedule" "kitchen" {
+  home_name = "My Home"
+  zone_name = "Kitchen"
+
+  mon_sun = provider::tado::schedule_merge(local.template, "12:00-13:00@off, 18:00-20:00@21.5")
+}
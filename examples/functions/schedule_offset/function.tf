@@ -0,0 +1,13 @@
+# The following example shows how to derive a cooler schedule for a bedroom
+# from the schedule of the living room.
+
+locals {
+  living_room = provider::tado::schedule("06:00-09:00@20.5, 17:00-22:00@21")
+}
+
+resource "tado_heating_schedule" "bedroom" {
+  home_name = "My Home"
+  zone_name = "Bedroom"
+
+  mon_sun = provider::tado::schedule_offset(local.living_room, -2)
+}
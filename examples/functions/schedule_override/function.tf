@@ -0,0 +1,15 @@
+# The following example shows how to keep an office warm during working hours
+# on weekdays, based on the schedule of the other days.
+
+locals {
+  weekend = provider::tado::schedule("08:00-23:00@20")
+}
+
+resource "tado_heating_schedule" "office" {
+  home_name = "My Home"
+  zone_name = "Office"
+
+  mon_fri = provider::tado::schedule_override(local.weekend, "08:00-17:00", 21)
+  sat     = local.weekend
+  sun     = local.weekend
+}
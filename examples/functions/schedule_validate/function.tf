@@ -0,0 +1,10 @@
+# The following example shows how to check a schedule which is assembled from
+# several sources before it is used.
+
+locals {
+  schedule = concat(var.morning_blocks, var.evening_blocks)
+}
+
+output "schedule_problems" {
+  value = provider::tado::schedule_validate(local.schedule)
+}
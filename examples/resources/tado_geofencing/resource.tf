@@ -2,3 +2,12 @@ resource "tado_geofencing" "auto" {
   home_name = "My Home"
   presence  = "auto"
 }
+
+# The following example shows how to lock a home in away mode while it is
+# managed, and to leave it in away mode when the resource is destroyed.
+
+resource "tado_geofencing" "holiday" {
+  home_name  = "Holiday Home"
+  presence   = "away"
+  on_destroy = "keep"
+}
@@ -101,4 +101,100 @@ resource "tado_heating_schedule" "bedroom" {
   ]
 }
 
+# The following example shows how to describe the schedule day by day. The
+# provider picks the most compact timetable matching the days, in this case
+# Monday - Friday, Saturday and Sunday.
+
+locals {
+  workday = [
+    { heating = false, start = "00:00", end = "06:30" },
+    { heating = true, temperature = 20.0, start = "06:30", end = "22:00" },
+    { heating = false, start = "22:00", end = "00:00" },
+  ]
+}
+
+resource "tado_heating_schedule" "study" {
+  home_name = "My Home"
+  zone_name = "Study"
+
+  days = {
+    mon = local.workday
+    tue = local.workday
+    wed = local.workday
+    thu = local.workday
+    fri = local.workday
+    sat = [
+      { heating = false, start = "00:00", end = "09:00" },
+      { heating = true, temperature = 19.0, start = "09:00", end = "00:00" },
+    ]
+    sun = [
+      { heating = false, start = "00:00", end = "00:00" },
+    ]
+  }
+}
+
+# The following example shows how to manage several timetables at once. Only
+# the active timetable is used by tado, switching to the holiday schedule is a
+# matter of setting active_timetable = "mon_sun".
 
+resource "tado_heating_schedule" "lounge" {
+  home_name = "My Home"
+  zone_name = "Lounge"
+
+  timetables = {
+    mon_sun = [
+      { heating = true, temperature = 16.0, start = "00:00", end = "00:00" },
+    ]
+    mon_fri_sat_sun = {
+      mon_fri = [
+        { heating = false, start = "00:00", end = "17:00" },
+        { heating = true, temperature = 21.0, start = "17:00", end = "23:00" },
+        { heating = false, start = "23:00", end = "00:00" },
+      ]
+      sat = [
+        { heating = false, start = "00:00", end = "09:00" },
+        { heating = true, temperature = 21.0, start = "09:00", end = "00:00" },
+      ]
+      sun = [
+        { heating = true, temperature = 21.0, start = "00:00", end = "22:00" },
+        { heating = false, start = "22:00", end = "00:00" },
+      ]
+    }
+  }
+
+  active_timetable = "mon_fri_sat_sun"
+}
+
+# The following example shows how to put a zone into frost protection when the
+# heating schedule resource is destroyed. Use on_destroy = "restore" instead to
+# restore the schedule the zone had before the resource was created.
+
+resource "tado_heating_schedule" "guest_room" {
+  home_name = "My Home"
+  zone_name = "Guest Room"
+
+  mon_sun = [
+    { heating = false, start = "00:00", end = "17:00" },
+    { heating = true, temperature = 20.0, start = "17:00", end = "22:00" },
+    { heating = false, start = "22:00", end = "00:00" },
+  ]
+
+  on_destroy = "reset"
+  reset_schedule = [
+    { heating = true, temperature = 12.0, start = "00:00", end = "00:00" },
+  ]
+}
+
+# The following example shows how to refer to the home and zone by their IDs.
+# Unlike names, IDs don't change when the zone is renamed in the tado app.
+
+resource "tado_heating_schedule" "office" {
+  home_id = 123456
+  zone_id = 7
+
+  mon_sun = [
+    { heating = false, start = "00:00", end = "08:00" },
+    { heating = true, temperature = 20.0, start = "08:00", end = "18:00" },
+    { heating = false, start = "18:00", end = "00:00" },
+  ]
+}
@@ -0,0 +1,35 @@
+# The following example shows how to apply the same heating schedule to a
+# number of meeting rooms.
+
+resource "tado_heating_schedule_group" "meeting_rooms" {
+  home_name  = "Office"
+  zone_names = ["Meeting Room 1", "Meeting Room 2", "Meeting Room 3"]
+
+  mon_fri = [
+    { heating = false, start = "00:00", end = "07:00" },
+    { heating = true, temperature = 21.0, start = "07:00", end = "18:00" },
+    { heating = false, start = "18:00", end = "00:00" },
+  ]
+
+  sat = [
+    { heating = false, start = "00:00", end = "00:00" },
+  ]
+
+  sun = [
+    { heating = false, start = "00:00", end = "00:00" },
+  ]
+}
+
+# The following example shows how to apply a heating schedule to all heating
+# zones of a home.
+
+resource "tado_heating_schedule_group" "all" {
+  home_name = "My Home"
+  zone_type = "HEATING"
+
+  mon_sun = [
+    { heating = false, start = "00:00", end = "06:00" },
+    { heating = true, temperature = 20.0, start = "06:00", end = "22:00" },
+    { heating = false, start = "22:00", end = "00:00" },
+  ]
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"time"
+)
+
+// convergence configures how long resources wait for the tado API to reflect
+// their writes. Right after a change, tado sometimes still returns the
+// previous value.
+type convergence struct {
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// defaultConvergence is used unless the provider configures another timeout
+// or interval.
+var defaultConvergence = convergence{timeout: 30 * time.Second, interval: 2 * time.Second}
+
+// waitForConvergence reads a value until converged reports true for it or
+// the timeout expires. It returns the last value read and whether it
+// converged. The value is read at least once, even if the timeout is zero.
+func waitForConvergence[T any](ctx context.Context, c convergence, read func(context.Context) (T, error), converged func(T) bool) (T, bool, error) {
+	deadline := time.Now().Add(c.timeout)
+	for {
+		value, err := read(ctx)
+		if err != nil {
+			return value, false, err
+		}
+		if converged(value) {
+			return value, true, nil
+		}
+		if time.Now().Add(c.interval).After(deadline) {
+			return value, false, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return value, false, ctx.Err()
+		case <-time.After(c.interval):
+		}
+	}
+}
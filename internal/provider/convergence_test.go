@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForConvergence(t *testing.T) {
+	errRead := errors.New("read failed")
+
+	cases := map[string]struct {
+		timeout   time.Duration
+		values    []int
+		err       error
+		expected  int
+		converged bool
+		reads     int
+	}{
+		"converged on first read": {
+			timeout:   time.Second,
+			values:    []int{1},
+			expected:  1,
+			converged: true,
+			reads:     1,
+		},
+		"converged after stale reads": {
+			timeout:   time.Second,
+			values:    []int{0, 0, 1},
+			expected:  1,
+			converged: true,
+			reads:     3,
+		},
+		"not converged within timeout": {
+			timeout:   5 * time.Millisecond,
+			values:    []int{0},
+			expected:  0,
+			converged: false,
+		},
+		"zero timeout reads once": {
+			timeout:   0,
+			values:    []int{0, 1},
+			expected:  0,
+			converged: false,
+			reads:     1,
+		},
+		"read error": {
+			timeout:   time.Second,
+			values:    []int{0},
+			err:       errRead,
+			converged: false,
+			reads:     1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reads := 0
+			read := func(context.Context) (int, error) {
+				value := tc.values[min(reads, len(tc.values)-1)]
+				reads++
+				return value, tc.err
+			}
+
+			value, converged, err := waitForConvergence(context.Background(), convergence{timeout: tc.timeout, interval: time.Millisecond}, read, func(value int) bool { return value == 1 })
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("Expected: %v, got: %v", tc.err, err)
+			}
+			if converged != tc.converged {
+				t.Fatalf("Expected: converged %t, got: %t", tc.converged, converged)
+			}
+			if value != tc.expected {
+				t.Fatalf("Expected: %d, got: %d", tc.expected, value)
+			}
+			if tc.reads > 0 && reads != tc.reads {
+				t.Fatalf("Expected: %d reads, got: %d", tc.reads, reads)
+			}
+		})
+	}
+}
@@ -3,54 +3,116 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &GeofencingResource{}
 var _ resource.ResourceWithImportState = &GeofencingResource{}
+var _ resource.ResourceWithModifyPlan = &GeofencingResource{}
+
+// onDestroyKeep keeps the presence of a home when a geofencing resource is
+// destroyed.
+const onDestroyKeep = "keep"
 
 func NewGeofencingResource() resource.Resource {
 	return &GeofencingResource{}
 }
 
 type GeofencingResource struct {
-	client *gotado.Tado
+	client      *gotado.Tado
+	convergence convergence
 }
 
 type GeofencingResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	HomeName types.String `tfsdk:"home_name"`
-	Presence types.String `tfsdk:"presence"`
+	ID               types.String   `tfsdk:"id"`
+	HomeID           types.Int64    `tfsdk:"home_id"`
+	HomeName         types.String   `tfsdk:"home_name"`
+	Presence         types.String   `tfsdk:"presence"`
+	PresenceLocked   types.Bool     `tfsdk:"presence_locked"`
+	DetectedPresence types.String   `tfsdk:"detected_presence"`
+	OnDestroy        types.String   `tfsdk:"on_destroy"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (*GeofencingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_geofencing"
 }
 
-func (GeofencingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (GeofencingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Controls geofencing of a home.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "ID of this geofencing resource. This should match the home_name.",
+				MarkdownDescription: "ID of this geofencing resource. This matches the home_id.",
+				Computed:            true,
+			},
+			"home_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the home this geofencing resource belongs to. Either `home_id` or `home_name` must be set.",
+				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateForUnknownUnlessChanged(path.Root("home_name")),
+				},
 			},
 			"home_name": schema.StringAttribute{
-				MarkdownDescription: "Name of the home this geofencing resource belongs to.",
-				Required:            true,
+				MarkdownDescription: "Name of the home this geofencing resource belongs to. Either `home_id` or `home_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("home_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					useStateForUnknownUnlessChanged(path.Root("home_id")),
+				},
 			},
 			"presence": schema.StringAttribute{
 				MarkdownDescription: "Whether somebody is present in the home. Can be one of 'auto', 'home' or 'away'.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "home", "away"),
+				},
+			},
+			"presence_locked": schema.BoolAttribute{
+				MarkdownDescription: "Whether the presence is locked to 'home' or 'away'. If false, presence is determined automatically by geofencing.",
+				Computed:            true,
+			},
+			"detected_presence": schema.StringAttribute{
+				MarkdownDescription: "Presence tado detects from the locations of the mobile devices of the home, either 'home' or 'away'. Null if no mobile device reports its location.",
+				Computed:            true,
 			},
+			"on_destroy": schema.StringAttribute{
+				MarkdownDescription: "Presence the home is set to when this resource is destroyed. Can be one of 'keep' (leave the presence as it is), 'auto', 'home' or 'away'. Defaults to 'auto', so that a home doesn't stay locked in away mode.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("auto"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyKeep, "auto", "home", "away"),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -72,29 +134,46 @@ func (r *GeofencingResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	r.client = gotado.NewWithTokenRefreshCallback(ctx, data.config, data.token, createTokenUpdateCallback(data.tokenPath, &resp.Diagnostics))
+	r.client = data.newClient(ctx, &resp.Diagnostics)
+	r.convergence = data.convergence
 }
 
 func (r GeofencingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data GeofencingResourceModel
 
-	diags := req.Config.Get(ctx, &data)
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	me, err := r.client.Me(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
 		return
 	}
 
-	homeName := data.HomeName.ValueString()
-	home, err := me.GetHome(ctx, homeName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get home '%s': %v", homeName, err))
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkName(path.Root("home_name"), "Home", data.HomeName, home.Name)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -106,9 +185,6 @@ func (r GeofencingResource) Create(ctx context.Context, req resource.CreateReque
 		err = home.SetPresenceHome(ctx)
 	case "away":
 		err = home.SetPresenceAway(ctx)
-	default:
-		resp.Diagnostics.AddError("Invalid Presence", fmt.Sprintf("Invalid presence value '%s', must be one of 'auto', 'home' or 'away'.", presence))
-		return
 	}
 
 	if err != nil {
@@ -116,21 +192,18 @@ func (r GeofencingResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	homeState, err := home.GetState(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get state of home '%s': %v", homeName, err))
+	homeState, diags := r.waitForPresence(ctx, home, presence)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	presence = strings.ToLower(string(homeState.Presence))
-	// If presence is not locked, it is set to 'auto'.
-	if !homeState.PresenceLocked {
-		presence = "auto"
-	}
+	resp.Diagnostics.Append(readGeofencing(ctx, home, homeState, &data)...)
 
-	data.ID = types.StringValue(home.Name)
-	data.HomeName = types.StringValue(home.Name)
-	data.Presence = types.StringValue(presence)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -146,34 +219,44 @@ func (r GeofencingResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	me, err := r.client.Me(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
 		return
 	}
 
-	homeName := data.HomeName.ValueString()
-	home, err := me.GetHome(ctx, homeName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get home '%s': %v", homeName, err))
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	homeState, err := home.GetState(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get state of home '%s': %v", homeName, err))
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get state of home '%s': %v", home.Name, err))
 		return
 	}
 
-	presence := strings.ToLower(string(homeState.Presence))
-	// If presence is not locked, it is set to 'auto'.
-	if !homeState.PresenceLocked {
-		presence = "auto"
-	}
+	resp.Diagnostics.Append(readGeofencing(ctx, home, homeState, &data)...)
 
-	data.ID = types.StringValue(home.Name)
-	data.HomeName = types.StringValue(home.Name)
-	data.Presence = types.StringValue(presence)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Imported resources don't know on_destroy yet.
+	if data.OnDestroy.IsNull() {
+		data.OnDestroy = types.StringValue("auto")
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -189,16 +272,32 @@ func (r GeofencingResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	me, err := r.client.Me(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
 		return
 	}
 
-	homeName := data.HomeName.ValueString()
-	home, err := me.GetHome(ctx, homeName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get home '%s': %v", homeName, err))
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkName(path.Root("home_name"), "Home", data.HomeName, home.Name)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -210,9 +309,6 @@ func (r GeofencingResource) Update(ctx context.Context, req resource.UpdateReque
 		err = home.SetPresenceHome(ctx)
 	case "away":
 		err = home.SetPresenceAway(ctx)
-	default:
-		resp.Diagnostics.AddError("Invalid Presence", fmt.Sprintf("Invalid presence value '%s', must be one of 'auto', 'home' or 'away'.", presence))
-		return
 	}
 
 	if err != nil {
@@ -220,27 +316,24 @@ func (r GeofencingResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	homeState, err := home.GetState(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get state of home '%s': %v", homeName, err))
+	homeState, diags := r.waitForPresence(ctx, home, presence)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	presence = strings.ToLower(string(homeState.Presence))
-	// If presence is not locked, it is set to 'auto'.
-	if !homeState.PresenceLocked {
-		presence = "auto"
-	}
+	resp.Diagnostics.Append(readGeofencing(ctx, home, homeState, &data)...)
 
-	data.ID = types.StringValue(home.Name)
-	data.HomeName = types.StringValue(home.Name)
-	data.Presence = types.StringValue(presence)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
-func (GeofencingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r GeofencingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data GeofencingResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -250,9 +343,193 @@ func (GeofencingResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	// No deletion necesary on tado api.
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	onDestroy := data.OnDestroy.ValueString()
+	if data.OnDestroy.IsNull() || onDestroy == onDestroyKeep {
+		// Geofencing can't be deleted, so we simply keep the presence.
+		return
+	}
+
+	me, err := r.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch onDestroy {
+	case "auto":
+		err = home.SetPresenceAuto(ctx)
+	case "home":
+		err = home.SetPresenceHome(ctx)
+	case "away":
+		err = home.SetPresenceAway(ctx)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to set presence to '%s': %v", onDestroy, err))
+		return
+	}
+
+	_, diags = r.waitForPresence(ctx, home, onDestroy)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (GeofencingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("home_name"), req, resp)
+	homeID, homeName := parseImportIdentifier(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("home_id"), homeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("home_name"), homeName)...)
+}
+
+func (r GeofencingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan if the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data GeofencingResourceModel
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || data.Presence.IsUnknown() {
+		return
+	}
+
+	presence := data.Presence.ValueString()
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("presence_locked"), presence != "auto")...)
+
+	// Nothing to check if presence is determined by geofencing or if the
+	// provider is not configured yet.
+	if resp.Diagnostics.HasError() || presence == "auto" || r.client == nil || (data.HomeID.IsUnknown() && data.HomeName.IsUnknown()) {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	me, err := r.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mobileDevices, err := home.GetMobileDevices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get mobile devices of home '%s': %v", home.Name, err))
+		return
+	}
+
+	if detected := detectedPresence(mobileDevices); detected != "" && detected != presence {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("presence"),
+			"Presence Differs From Mobile Devices",
+			fmt.Sprintf("Presence of home '%s' is locked to '%s', but its mobile devices report '%s'. Geofencing won't change the presence until it is set to 'auto'.", home.Name, presence, detected),
+		)
+	}
+}
+
+// waitForPresence reads the state of a home until its presence is the given
+// presence, which has just been set.
+func (r GeofencingResource) waitForPresence(ctx context.Context, home *gotado.Home, presence string) (*gotado.HomeState, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	homeState, converged, err := waitForConvergence(ctx, r.convergence, home.GetState, func(homeState *gotado.HomeState) bool {
+		return homePresence(homeState) == presence
+	})
+	if err != nil {
+		diags.AddError("Tado API Error", fmt.Sprintf("Unable to get state of home '%s': %v", home.Name, err))
+		return nil, diags
+	}
+	if !converged {
+		diags.AddError(
+			"Tado API Error",
+			fmt.Sprintf("Presence of home '%s' is still '%s' %s after setting it to '%s'. Increase 'convergence_timeout' of the provider if tado takes longer to apply changes.", home.Name, homePresence(homeState), r.convergence.timeout, presence),
+		)
+		return nil, diags
+	}
+
+	return homeState, diags
+}
+
+// homePresence returns the presence of a home as used by the geofencing
+// resource: 'home' or 'away' if presence is locked, 'auto' otherwise.
+func homePresence(homeState *gotado.HomeState) string {
+	if !homeState.PresenceLocked {
+		return "auto"
+	}
+	return strings.ToLower(string(homeState.Presence))
+}
+
+// readGeofencing reads the given state of a home and the presence detected
+// from its mobile devices into the geofencing model.
+func readGeofencing(ctx context.Context, home *gotado.Home, homeState *gotado.HomeState, data *GeofencingResourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	mobileDevices, err := home.GetMobileDevices(ctx)
+	if err != nil {
+		diags.AddError("Tado API Error", fmt.Sprintf("Unable to get mobile devices of home '%s': %v", home.Name, err))
+		return diags
+	}
+
+	data.ID = types.StringValue(strconv.Itoa(int(home.ID)))
+	data.HomeID = types.Int64Value(int64(home.ID))
+	data.HomeName = types.StringValue(home.Name)
+	data.Presence = types.StringValue(homePresence(homeState))
+	data.PresenceLocked = types.BoolValue(homeState.PresenceLocked)
+	data.DetectedPresence = types.StringNull()
+	if detected := detectedPresence(mobileDevices); detected != "" {
+		data.DetectedPresence = types.StringValue(detected)
+	}
+
+	return diags
+}
+
+// detectedPresence returns the presence geofencing detects from the mobile
+// devices of a home: 'home' if at least one device is at home, 'away'
+// otherwise. It returns an empty string if no device reports its location.
+func detectedPresence(mobileDevices []*gotado.MobileDevice) string {
+	located := false
+	for _, mobileDevice := range mobileDevices {
+		if mobileDevice.Location == nil {
+			continue
+		}
+		if mobileDevice.Location.AtHome {
+			return "home"
+		}
+		located = true
+	}
+	if !located {
+		return ""
+	}
+	return "away"
 }
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/gonzolino/gotado/v2"
+)
+
+func TestDetectedPresence(t *testing.T) {
+	cases := []struct {
+		mobileDevices []*gotado.MobileDevice
+		expected      string
+	}{
+		// no mobile devices
+		{
+			mobileDevices: []*gotado.MobileDevice{},
+			expected:      "",
+		},
+		// no mobile device reports its location
+		{
+			mobileDevices: []*gotado.MobileDevice{
+				{Name: "Phone A", Location: nil},
+			},
+			expected: "",
+		},
+		// one mobile device at home is enough
+		{
+			mobileDevices: []*gotado.MobileDevice{
+				{Name: "Phone A", Location: &gotado.MobileDeviceLocation{AtHome: false}},
+				{Name: "Phone B", Location: nil},
+				{Name: "Phone C", Location: &gotado.MobileDeviceLocation{AtHome: true}},
+			},
+			expected: "home",
+		},
+		// all located mobile devices are away
+		{
+			mobileDevices: []*gotado.MobileDevice{
+				{Name: "Phone A", Location: &gotado.MobileDeviceLocation{AtHome: false}},
+				{Name: "Phone B", Location: nil},
+			},
+			expected: "away",
+		},
+	}
+
+	for _, c := range cases {
+		actual := detectedPresence(c.mobileDevices)
+		if actual != c.expected {
+			t.Fatalf("Expected: %s, got: %s", c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"math"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HeatingScheduleAnalyticsModel holds figures computed from a weekly heating
+// schedule.
+type HeatingScheduleAnalyticsModel struct {
+	HeatingHours       types.Float64    `tfsdk:"heating_hours"`
+	DegreeHours        types.Float64    `tfsdk:"degree_hours"`
+	MinTemperature     types.Float64    `tfsdk:"min_temperature"`
+	MaxTemperature     types.Float64    `tfsdk:"max_temperature"`
+	AverageTemperature types.Float64    `tfsdk:"average_temperature"`
+	SwitchesPerDay     map[string]int64 `tfsdk:"switches_per_day"`
+}
+
+// heatingScheduleAnalyticsAttrTypes are the attribute types of the analytics
+// object.
+var heatingScheduleAnalyticsAttrTypes = map[string]attr.Type{
+	"heating_hours":       types.Float64Type,
+	"degree_hours":        types.Float64Type,
+	"min_temperature":     types.Float64Type,
+	"max_temperature":     types.Float64Type,
+	"average_temperature": types.Float64Type,
+	"switches_per_day":    types.MapType{ElemType: types.Int64Type},
+}
+
+// heatingScheduleAnalytics computes the analytics of a weekly heating
+// schedule. The result is unknown if the schedule or any of its time blocks is
+// unknown.
+func heatingScheduleAnalytics(ctx context.Context, days *HeatingScheduleDaysModel) (types.Object, diag.Diagnostics) {
+	if days == nil || !isCompleteDaysModel(*days) {
+		return types.ObjectUnknown(heatingScheduleAnalyticsAttrTypes), nil
+	}
+
+	var heatingMinutes, degreeMinutes float64
+	minTemperature, maxTemperature := math.Inf(1), math.Inf(-1)
+	switches := make(map[string]int64, 7)
+	for day, blocks := range map[string][]TimeBlockModel{
+		"mon": days.Mon, "tue": days.Tue, "wed": days.Wed, "thu": days.Thu, "fri": days.Fri, "sat": days.Sat, "sun": days.Sun,
+	} {
+		normalized, _ := normalizeTimeBlockModels(blocks, gotado.TemperatureUnitCelsius)
+		switches[day] = int64(max(len(normalized)-1, 0))
+		for _, block := range normalized {
+			if !block.heating {
+				continue
+			}
+			minutes := float64(block.end - block.start)
+			temperature := float64(block.temperature) / 10
+			heatingMinutes += minutes
+			degreeMinutes += temperature * minutes
+			minTemperature = math.Min(minTemperature, temperature)
+			maxTemperature = math.Max(maxTemperature, temperature)
+		}
+	}
+
+	analytics := HeatingScheduleAnalyticsModel{
+		HeatingHours:       types.Float64Value(roundAnalytics(heatingMinutes / 60)),
+		DegreeHours:        types.Float64Value(roundAnalytics(degreeMinutes / 60)),
+		MinTemperature:     types.Float64Null(),
+		MaxTemperature:     types.Float64Null(),
+		AverageTemperature: types.Float64Null(),
+		SwitchesPerDay:     switches,
+	}
+	if heatingMinutes > 0 {
+		analytics.MinTemperature = types.Float64Value(minTemperature)
+		analytics.MaxTemperature = types.Float64Value(maxTemperature)
+		analytics.AverageTemperature = types.Float64Value(roundAnalytics(degreeMinutes / heatingMinutes))
+	}
+
+	return types.ObjectValueFrom(ctx, heatingScheduleAnalyticsAttrTypes, analytics)
+}
+
+// roundAnalytics rounds a computed figure to two decimal places.
+func roundAnalytics(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// heatingScheduleModelToDaysModel expands the active schedule of a resource
+// model to the time blocks of each day of the week. It returns nil if the
+// active schedule is not known.
+func heatingScheduleModelToDaysModel(data HeatingScheduleResourceModel) *HeatingScheduleDaysModel {
+	if data.Days != nil {
+		return data.Days
+	}
+	if data.Timetables != nil {
+		if data.ActiveTimetable.IsNull() || data.ActiveTimetable.IsUnknown() {
+			return nil
+		}
+		timetable, ok := timetablesToResourceModels(*data.Timetables, data.TemperatureUnit)[data.ActiveTimetable.ValueString()]
+		if !ok {
+			return nil
+		}
+		data = timetable
+	}
+
+	switch {
+	case isMonSunSchedule(data):
+		return &HeatingScheduleDaysModel{Mon: data.MonSun, Tue: data.MonSun, Wed: data.MonSun, Thu: data.MonSun, Fri: data.MonSun, Sat: data.MonSun, Sun: data.MonSun}
+	case isMonFriSatSunSchedule(data):
+		return &HeatingScheduleDaysModel{Mon: data.MonFri, Tue: data.MonFri, Wed: data.MonFri, Thu: data.MonFri, Fri: data.MonFri, Sat: data.Sat, Sun: data.Sun}
+	case isMonTueWedThuFriSatSunSchedule(data):
+		return &HeatingScheduleDaysModel{Mon: data.Mon, Tue: data.Tue, Wed: data.Wed, Thu: data.Thu, Fri: data.Fri, Sat: data.Sat, Sun: data.Sun}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHeatingScheduleAnalytics(t *testing.T) {
+	block := func(start, end string, heating bool, temperature float64) TimeBlockModel {
+		model := TimeBlockModel{
+			Heating:           types.BoolValue(heating),
+			Temperature:       types.Float64Null(),
+			Start:             types.StringValue(start),
+			End:               types.StringValue(end),
+			GeofencingControl: types.BoolNull(),
+		}
+		if heating {
+			model.Temperature = types.Float64Value(temperature)
+		}
+		return model
+	}
+	weekday := []TimeBlockModel{
+		block("00:00", "06:00", false, 0),
+		block("06:00", "08:00", true, 21),
+		block("08:00", "17:00", true, 18),
+		block("17:00", "22:00", true, 21),
+		block("22:00", "00:00", false, 0),
+	}
+	weekend := []TimeBlockModel{
+		block("00:00", "08:00", false, 0),
+		block("08:00", "12:00", true, 20.5),
+		block("12:00", "23:00", true, 20.5),
+		block("23:00", "00:00", false, 0),
+	}
+	off := []TimeBlockModel{block("00:00", "00:00", false, 0)}
+
+	cases := map[string]struct {
+		days     *HeatingScheduleDaysModel
+		expected HeatingScheduleAnalyticsModel
+	}{
+		"weekdays and weekend": {
+			days: &HeatingScheduleDaysModel{Mon: weekday, Tue: weekday, Wed: weekday, Thu: weekday, Fri: weekday, Sat: weekend, Sun: weekend},
+			expected: HeatingScheduleAnalyticsModel{
+				HeatingHours:       types.Float64Value(110),
+				DegreeHours:        types.Float64Value(2160),
+				MinTemperature:     types.Float64Value(18),
+				MaxTemperature:     types.Float64Value(21),
+				AverageTemperature: types.Float64Value(19.64),
+				SwitchesPerDay:     map[string]int64{"mon": 4, "tue": 4, "wed": 4, "thu": 4, "fri": 4, "sat": 2, "sun": 2},
+			},
+		},
+		"never heating": {
+			days: &HeatingScheduleDaysModel{Mon: off, Tue: off, Wed: off, Thu: off, Fri: off, Sat: off, Sun: off},
+			expected: HeatingScheduleAnalyticsModel{
+				HeatingHours:       types.Float64Value(0),
+				DegreeHours:        types.Float64Value(0),
+				MinTemperature:     types.Float64Null(),
+				MaxTemperature:     types.Float64Null(),
+				AverageTemperature: types.Float64Null(),
+				SwitchesPerDay:     map[string]int64{"mon": 0, "tue": 0, "wed": 0, "thu": 0, "fri": 0, "sat": 0, "sun": 0},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			object, diags := heatingScheduleAnalytics(context.Background(), tc.days)
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			expected, diags := types.ObjectValueFrom(context.Background(), heatingScheduleAnalyticsAttrTypes, tc.expected)
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			if !object.Equal(expected) {
+				t.Fatalf("Expected: %s, got: %s", expected, object)
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		unknown := []TimeBlockModel{{
+			Heating:           types.BoolUnknown(),
+			Temperature:       types.Float64Unknown(),
+			Start:             types.StringUnknown(),
+			End:               types.StringUnknown(),
+			GeofencingControl: types.BoolUnknown(),
+		}}
+		days := &HeatingScheduleDaysModel{Mon: unknown, Tue: off, Wed: off, Thu: off, Fri: off, Sat: off, Sun: off}
+		if object, _ := heatingScheduleAnalytics(context.Background(), days); !object.IsUnknown() {
+			t.Fatalf("Expected: unknown, got: %s", object)
+		}
+		if object, _ := heatingScheduleAnalytics(context.Background(), nil); !object.IsUnknown() {
+			t.Fatalf("Expected: unknown, got: %s", object)
+		}
+	})
+}
+
+func TestHeatingScheduleModelToDaysModel(t *testing.T) {
+	blocks := []TimeBlockModel{{Heating: types.BoolValue(false), Start: types.StringValue("00:00"), End: types.StringValue("00:00")}}
+	weekend := []TimeBlockModel{{Heating: types.BoolValue(true), Temperature: types.Float64Value(20), Start: types.StringValue("00:00"), End: types.StringValue("00:00")}}
+
+	days := heatingScheduleModelToDaysModel(HeatingScheduleResourceModel{MonFri: blocks, Sat: weekend, Sun: weekend})
+	if days == nil {
+		t.Fatalf("Expected: days, got: nil")
+	}
+	if len(days.Wed) != 1 || !days.Wed[0].Heating.Equal(types.BoolValue(false)) || len(days.Sun) != 1 || !days.Sun[0].Heating.Equal(types.BoolValue(true)) {
+		t.Errorf("Expected: weekdays off and weekend heating, got: %v", days)
+	}
+
+	timetables := &HeatingScheduleTimetablesModel{MonSun: blocks}
+	if days := heatingScheduleModelToDaysModel(HeatingScheduleResourceModel{Timetables: timetables, ActiveTimetable: types.StringValue(timetableMonSun)}); days == nil || len(days.Sat) != 1 {
+		t.Errorf("Expected: days of the active timetable, got: %v", days)
+	}
+	if days := heatingScheduleModelToDaysModel(HeatingScheduleResourceModel{Timetables: timetables, ActiveTimetable: types.StringValue(timetableAllDays)}); days != nil {
+		t.Errorf("Expected: nil for an unmanaged active timetable, got: %v", days)
+	}
+	if days := heatingScheduleModelToDaysModel(HeatingScheduleResourceModel{Timetables: timetables, ActiveTimetable: types.StringUnknown()}); days != nil {
+		t.Errorf("Expected: nil for an unknown active timetable, got: %v", days)
+	}
+}
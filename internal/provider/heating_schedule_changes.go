@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// weekdayNames are the short names of the days of the week, starting on
+// Monday like HeatingScheduleDaysModel.
+var weekdayNames = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+// daysModelBlocks returns the time blocks of each day of the week, starting on
+// Monday.
+func daysModelBlocks(days HeatingScheduleDaysModel) [][]TimeBlockModel {
+	return [][]TimeBlockModel{days.Mon, days.Tue, days.Wed, days.Thu, days.Fri, days.Sat, days.Sun}
+}
+
+// weekdayIndex returns the index of the day of the given time in weekdayNames.
+func weekdayIndex(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
+
+// homeLocation returns the time zone of a home, or UTC if it is unknown.
+func homeLocation(home *gotado.Home) *time.Location {
+	location, err := time.LoadLocation(home.DateTimeZone)
+	if err != nil || home.DateTimeZone == "" {
+		return time.UTC
+	}
+	return location
+}
+
+// heatingScheduleChangesWarning returns a warning which summarizes the changes
+// between two weekly schedules day by day, and whether they affect the time
+// block which is active at the given time. Nothing is returned if the
+// schedules are equal or any of their blocks is unknown.
+func heatingScheduleChangesWarning(zoneName string, prior, planned HeatingScheduleDaysModel, unit gotado.TemperatureUnit, now time.Time) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	priorDays, plannedDays := normalizeDaysModel(prior, unit), normalizeDaysModel(planned, unit)
+	if priorDays == nil || plannedDays == nil {
+		return diags
+	}
+
+	changes := summarizeHeatingScheduleChanges(priorDays, plannedDays, unit)
+	if len(changes) == 0 {
+		return diags
+	}
+
+	day, minute := weekdayIndex(now), now.Hour()*60+now.Minute()
+	priorBlock, _ := timeBlockAt(priorDays[day], minute)
+	plannedBlock, ok := timeBlockAt(plannedDays[day], minute)
+	active := fmt.Sprintf("The time block which is active right now (%s %s) is not affected.", weekdayNames[day], formatTimeRange(plannedBlock.start, plannedBlock.end))
+	if !ok || priorBlock != plannedBlock {
+		active = fmt.Sprintf("The change affects the time block which is active right now (%s %s).", weekdayNames[day], formatTimeRange(plannedBlock.start, plannedBlock.end))
+	}
+
+	diags.AddWarning(
+		"Heating Schedule Changes",
+		fmt.Sprintf("The heating schedule of zone '%s' changes as follows:\n%s\n\n%s", zoneName, strings.Join(changes, "\n"), active),
+	)
+	return diags
+}
+
+// normalizeDaysModel normalizes the time blocks of each day of the week. It
+// returns nil if any of the days is missing or can't be normalized.
+func normalizeDaysModel(days HeatingScheduleDaysModel, unit gotado.TemperatureUnit) [][]normalizedTimeBlock {
+	normalized := make([][]normalizedTimeBlock, 0, len(weekdayNames))
+	for _, blocks := range daysModelBlocks(days) {
+		dayBlocks, ok := normalizeTimeBlockModels(blocks, unit)
+		if blocks == nil || !ok {
+			return nil
+		}
+		normalized = append(normalized, dayBlocks)
+	}
+	return normalized
+}
+
+// summarizeHeatingScheduleChanges returns a human readable summary of the
+// changes of each day of the week. Consecutive days with the same changes are
+// summarized in a single line, e.g. 'Mon–Fri 06:00–09:00: 19.0°C → 20.5°C'.
+func summarizeHeatingScheduleChanges(prior, planned [][]normalizedTimeBlock, unit gotado.TemperatureUnit) []string {
+	dayChanges := make([]string, len(weekdayNames))
+	for day := range weekdayNames {
+		dayChanges[day] = strings.Join(diffTimeBlocks(prior[day], planned[day], unit), "; ")
+	}
+
+	summary := []string{}
+	for first := 0; first < len(dayChanges); {
+		last := first
+		for last+1 < len(dayChanges) && dayChanges[last+1] == dayChanges[first] {
+			last++
+		}
+		if dayChanges[first] != "" {
+			days := weekdayNames[first]
+			if last > first {
+				days += "–" + weekdayNames[last]
+			}
+			summary = append(summary, days+" "+dayChanges[first])
+		}
+		first = last + 1
+	}
+	return summary
+}
+
+// diffTimeBlocks describes the periods of a day whose setting differs between
+// two lists of normalized time blocks. A period which is a new block of its
+// own is reported as added, a block which disappears as removed.
+func diffTimeBlocks(prior, planned []normalizedTimeBlock, unit gotado.TemperatureUnit) []string {
+	boundaries := []int{0, minutesPerDay}
+	for _, block := range append(slices.Clone(prior), planned...) {
+		boundaries = append(boundaries, block.start, block.end)
+	}
+	slices.Sort(boundaries)
+	boundaries = slices.Compact(boundaries)
+
+	type period struct {
+		start, end     int
+		prior, planned normalizedTimeBlock
+		inPrior        bool
+		inPlanned      bool
+	}
+	var periods []period
+	for i := 0; i+1 < len(boundaries); i++ {
+		start, end := boundaries[i], boundaries[i+1]
+		priorBlock, inPrior := timeBlockAt(prior, start)
+		plannedBlock, inPlanned := timeBlockAt(planned, start)
+		if inPrior == inPlanned && sameSetting(priorBlock, plannedBlock) {
+			continue
+		}
+		if n := len(periods); n > 0 && periods[n-1].end == start && sameSetting(periods[n-1].prior, priorBlock) && sameSetting(periods[n-1].planned, plannedBlock) {
+			periods[n-1].end = end
+			continue
+		}
+		periods = append(periods, period{start, end, priorBlock, plannedBlock, inPrior, inPlanned})
+	}
+
+	hasBlock := func(blocks []normalizedTimeBlock, start, end int) bool {
+		return slices.ContainsFunc(blocks, func(block normalizedTimeBlock) bool {
+			return block.start == start && block.end == end
+		})
+	}
+
+	changes := make([]string, 0, len(periods))
+	for _, p := range periods {
+		timeRange := formatTimeRange(p.start, p.end)
+		inPriorBlocks, inPlannedBlocks := hasBlock(prior, p.start, p.end), hasBlock(planned, p.start, p.end)
+		switch {
+		case inPlannedBlocks && !inPriorBlocks:
+			changes = append(changes, fmt.Sprintf("block %s added (%s)", timeRange, formatSetting(p.planned, p.inPlanned, unit)))
+		case inPriorBlocks && !inPlannedBlocks:
+			changes = append(changes, fmt.Sprintf("block %s removed (%s)", timeRange, formatSetting(p.prior, p.inPrior, unit)))
+		default:
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", timeRange, formatSetting(p.prior, p.inPrior, unit), formatSetting(p.planned, p.inPlanned, unit)))
+		}
+	}
+	return changes
+}
+
+// timeBlockAt returns the time block which is active at the given minute of
+// the day.
+func timeBlockAt(blocks []normalizedTimeBlock, minute int) (normalizedTimeBlock, bool) {
+	for _, block := range blocks {
+		if block.start <= minute && minute < block.end {
+			return block, true
+		}
+	}
+	return normalizedTimeBlock{}, false
+}
+
+// sameSetting checks if two time blocks have the same setting, regardless of
+// when they start and end.
+func sameSetting(a, b normalizedTimeBlock) bool {
+	return a.heating == b.heating && a.temperature == b.temperature && a.geofencingControl == b.geofencingControl
+}
+
+// formatSetting formats the setting of a normalized time block, e.g. '20.5°C'
+// or 'off'.
+func formatSetting(block normalizedTimeBlock, ok bool, unit gotado.TemperatureUnit) string {
+	if !ok {
+		return "not set"
+	}
+	setting := "off"
+	if block.heating {
+		if unit == gotado.TemperatureUnitFahrenheit {
+			setting = fmt.Sprintf("%.0f°F", float64(block.temperature)/10)
+		} else {
+			setting = fmt.Sprintf("%.1f°C", float64(block.temperature)/10)
+		}
+	}
+	if !block.geofencingControl {
+		setting += " (no geofencing)"
+	}
+	return setting
+}
+
+// formatTimeRange formats a period of a day, e.g. '06:00–09:00'.
+func formatTimeRange(start, end int) string {
+	return formatTimeOfDay(start) + "–" + formatTimeOfDay(end)
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHeatingScheduleChangesWarning(t *testing.T) {
+	block := func(start, end string, temperature float64) TimeBlockModel {
+		model := TimeBlockModel{
+			Heating:           types.BoolValue(temperature > 0),
+			Temperature:       types.Float64Null(),
+			Start:             types.StringValue(start),
+			End:               types.StringValue(end),
+			GeofencingControl: types.BoolNull(),
+		}
+		if temperature > 0 {
+			model.Temperature = types.Float64Value(temperature)
+		}
+		return model
+	}
+	week := func(weekday, weekend []TimeBlockModel) HeatingScheduleDaysModel {
+		return HeatingScheduleDaysModel{Mon: weekday, Tue: weekday, Wed: weekday, Thu: weekday, Fri: weekday, Sat: weekend, Sun: weekend}
+	}
+
+	weekday := []TimeBlockModel{block("00:00", "06:00", 0), block("06:00", "09:00", 19), block("09:00", "00:00", 0)}
+	weekend := []TimeBlockModel{block("00:00", "08:00", 0), block("08:00", "00:00", 20)}
+	prior := week(weekday, weekend)
+
+	tuesday := prior
+	tuesday.Tue = []TimeBlockModel{block("00:00", "06:00", 0), block("06:00", "09:00", 20.5), block("09:00", "17:00", 0), block("17:00", "22:00", 21), block("22:00", "00:00", 0)}
+
+	// Tuesday at 07:30 and at 12:00.
+	morning := time.Date(2024, 1, 2, 7, 30, 0, 0, time.UTC)
+	noon := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		planned  HeatingScheduleDaysModel
+		unit     gotado.TemperatureUnit
+		now      time.Time
+		expected string
+	}{
+		"unchanged": {
+			planned:  prior,
+			unit:     gotado.TemperatureUnitCelsius,
+			now:      morning,
+			expected: "",
+		},
+		"single day": {
+			planned:  tuesday,
+			unit:     gotado.TemperatureUnitCelsius,
+			now:      morning,
+			expected: "The heating schedule of zone 'Living Room' changes as follows:\nTue 06:00–09:00: 19.0°C → 20.5°C; block 17:00–22:00 added (21.0°C)\n\nThe change affects the time block which is active right now (Tue 06:00–09:00).",
+		},
+		"active block shortened": {
+			planned:  tuesday,
+			unit:     gotado.TemperatureUnitCelsius,
+			now:      noon,
+			expected: "The heating schedule of zone 'Living Room' changes as follows:\nTue 06:00–09:00: 19.0°C → 20.5°C; block 17:00–22:00 added (21.0°C)\n\nThe change affects the time block which is active right now (Tue 09:00–17:00).",
+		},
+		"consecutive days": {
+			planned:  week([]TimeBlockModel{block("00:00", "06:00", 0), block("06:00", "09:00", 20), block("09:00", "00:00", 0)}, weekend),
+			unit:     gotado.TemperatureUnitCelsius,
+			now:      noon,
+			expected: "The heating schedule of zone 'Living Room' changes as follows:\nMon–Fri 06:00–09:00: 19.0°C → 20.0°C\n\nThe time block which is active right now (Tue 09:00–00:00) is not affected.",
+		},
+		"block removed": {
+			planned:  week(weekday, []TimeBlockModel{block("00:00", "00:00", 0)}),
+			unit:     gotado.TemperatureUnitCelsius,
+			now:      noon,
+			expected: "The heating schedule of zone 'Living Room' changes as follows:\nSat–Sun block 08:00–00:00 removed (20.0°C)\n\nThe time block which is active right now (Tue 09:00–00:00) is not affected.",
+		},
+		"fahrenheit": {
+			planned:  week([]TimeBlockModel{block("00:00", "06:00", 0), block("06:00", "09:00", 68), block("09:00", "00:00", 0)}, weekend),
+			unit:     gotado.TemperatureUnitFahrenheit,
+			now:      noon,
+			expected: "The heating schedule of zone 'Living Room' changes as follows:\nMon–Fri 06:00–09:00: 19°F → 68°F\n\nThe time block which is active right now (Tue 09:00–00:00) is not affected.",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := heatingScheduleChangesWarning("Living Room", prior, tc.planned, tc.unit, tc.now)
+			if tc.expected == "" {
+				if len(diags) != 0 {
+					t.Fatalf("Expected: no warning, got: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags.WarningsCount() != 1 {
+				t.Fatalf("Expected: a single warning, got: %v", diags)
+			}
+			if detail := diags[0].Detail(); detail != tc.expected {
+				t.Fatalf("Expected: %q, got: %q", tc.expected, detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &HeatingScheduleDataSource{}
+
+const (
+	timetableMonSun       = "mon_sun"
+	timetableMonFriSatSun = "mon_fri_sat_sun"
+	timetableAllDays      = "all_days"
+)
+
+func NewHeatingScheduleDataSource() datasource.DataSource {
+	return &HeatingScheduleDataSource{}
+}
+
+type HeatingScheduleDataSource struct {
+	client *gotado.Tado
+}
+
+type HeatingScheduleDataSourceModel struct {
+	ID        types.String     `tfsdk:"id"`
+	HomeID    types.Int64      `tfsdk:"home_id"`
+	HomeName  types.String     `tfsdk:"home_name"`
+	ZoneID    types.Int64      `tfsdk:"zone_id"`
+	ZoneName  types.String     `tfsdk:"zone_name"`
+	Timetable types.String     `tfsdk:"timetable"`
+	MonSun    []TimeBlockModel `tfsdk:"mon_sun"`
+	MonFri    []TimeBlockModel `tfsdk:"mon_fri"`
+	Mon       []TimeBlockModel `tfsdk:"mon"`
+	Tue       []TimeBlockModel `tfsdk:"tue"`
+	Wed       []TimeBlockModel `tfsdk:"wed"`
+	Thu       []TimeBlockModel `tfsdk:"thu"`
+	Fri       []TimeBlockModel `tfsdk:"fri"`
+	Sat       []TimeBlockModel `tfsdk:"sat"`
+	Sun       []TimeBlockModel `tfsdk:"sun"`
+
+	TemperatureUnit types.String `tfsdk:"temperature_unit"`
+
+	Days      *HeatingScheduleDaysModel `tfsdk:"days"`
+	Analytics types.Object              `tfsdk:"analytics"`
+	Timeouts  timeouts.Value            `tfsdk:"timeouts"`
+}
+
+var timeBlockDataSourceAttributes = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"heating": schema.BoolAttribute{
+			MarkdownDescription: "Whether heating is turned on or off",
+			Computed:            true,
+		},
+		"temperature": schema.Float64Attribute{
+			MarkdownDescription: "The temperature the heating is set to, in the unit given by 'temperature_unit'. Null when 'heating' is false",
+			Computed:            true,
+		},
+		"start": schema.StringAttribute{
+			MarkdownDescription: "When the timeblock starts. Format is 'hh:mm'.",
+			Computed:            true,
+		},
+		"end": schema.StringAttribute{
+			MarkdownDescription: "When the timeblock ends. Format is 'hh:mm'.",
+			Computed:            true,
+		},
+		"geofencing_control": schema.BoolAttribute{
+			MarkdownDescription: "Whether the settings of this time block are overwritten by the tado away settings.",
+			Computed:            true,
+		},
+	},
+}
+
+func (*HeatingScheduleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_heating_schedule"
+}
+
+func (HeatingScheduleDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The active heating schedule of a zone. Only the day attributes of the active timetable are set, the others are null. The blocks have the same format as the blocks of the `tado_heating_schedule` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of this heating schedule.",
+				Computed:            true,
+			},
+			"home_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the home the zone belongs to. Either `home_id` or `home_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"home_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the home the zone belongs to. Either `home_id` or `home_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("home_id")),
+				},
+			},
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone. Either `zone_id` or `zone_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"zone_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the zone. Either `zone_id` or `zone_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("zone_id")),
+				},
+			},
+			"temperature_unit": schema.StringAttribute{
+				MarkdownDescription: "Unit of the temperatures of the schedule. Either 'CELSIUS' or 'FAHRENHEIT'. Defaults to the temperature unit of the home.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(temperatureUnits...),
+				},
+			},
+			"timetable": schema.StringAttribute{
+				MarkdownDescription: "The active timetable of the zone. Can be one of 'mon_sun' (same schedule for every day), 'mon_fri_sat_sun' (one schedule for Monday - Friday and separate schedules for Saturday and Sunday) or 'all_days' (separate schedules for each day).",
+				Computed:            true,
+			},
+			"mon_sun": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Monday - Sunday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"mon_fri": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Monday - Friday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"mon": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Monday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"tue": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Tuesday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"wed": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Wednesday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"thu": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Thursday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"fri": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Friday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"sat": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Saturday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"sun": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Sunday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"days": schema.SingleNestedAttribute{
+				MarkdownDescription: "Schedule for each day of the week, regardless of the active timetable. Can be assigned to the `days` attribute of the `tado_heating_schedule` resource.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"mon": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Monday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"tue": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Tuesday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"wed": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Wednesday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"thu": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Thursday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"fri": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Friday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"sat": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Saturday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"sun": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Sunday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+				},
+			},
+			"analytics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Figures about the active schedule of the zone, computed from the time blocks as tado stores them. Temperatures are in the unit given by `temperature_unit`.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"heating_hours": schema.Float64Attribute{
+						MarkdownDescription: "Number of hours per week during which heating is turned on.",
+						Computed:            true,
+					},
+					"degree_hours": schema.Float64Attribute{
+						MarkdownDescription: "Sum of the temperature of each time block which turns heating on, multiplied by its duration in hours, over the whole week.",
+						Computed:            true,
+					},
+					"min_temperature": schema.Float64Attribute{
+						MarkdownDescription: "Lowest temperature heating is set to. Null if heating is never turned on.",
+						Computed:            true,
+					},
+					"max_temperature": schema.Float64Attribute{
+						MarkdownDescription: "Highest temperature heating is set to. Null if heating is never turned on.",
+						Computed:            true,
+					},
+					"average_temperature": schema.Float64Attribute{
+						MarkdownDescription: "Average temperature heating is set to, weighted by the duration of the time blocks. Null if heating is never turned on.",
+						Computed:            true,
+					},
+					"switches_per_day": schema.MapAttribute{
+						MarkdownDescription: "Number of changes of the setting within each day, keyed by 'mon' to 'sun'.",
+						ElementType:         types.Int64Type,
+						Computed:            true,
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *HeatingScheduleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*tadoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *tadoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.newClient(ctx, &resp.Diagnostics)
+}
+
+func (d HeatingScheduleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HeatingScheduleDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	me, err := d.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, diags := getZone(ctx, home, data.ZoneID, data.ZoneName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkName(path.Root("home_name"), "Home", data.HomeName, home.Name)...)
+	resp.Diagnostics.Append(checkName(path.Root("zone_name"), "Zone", data.ZoneName, zone.Name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := zone.GetHeatingSchedule(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get heating schedule for zone '%s': %v", zone.Name, err))
+		return
+	}
+
+	resp.Diagnostics.Append(heatingScheduleToDataSourceModel(ctx, home, zone, schedule, &data)...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// heatingScheduleToDataSourceModel converts a heating schedule into the data
+// source model. The conversion of the time blocks is shared with the heating
+// schedule resource, so that the blocks of the data source can be assigned to
+// the resource as-is.
+func heatingScheduleToDataSourceModel(ctx context.Context, home *gotado.Home, zone *gotado.Zone, schedule *gotado.HeatingSchedule, data *HeatingScheduleDataSourceModel) diag.Diagnostics {
+	model := HeatingScheduleResourceModel{TemperatureUnit: data.TemperatureUnit}
+	heatingScheduleToResourceData(ctx, home, zone, schedule, &model)
+
+	data.ID = model.ID
+	data.HomeID = model.HomeID
+	data.HomeName = model.HomeName
+	data.ZoneID = model.ZoneID
+	data.ZoneName = model.ZoneName
+	data.Timetable = types.StringValue(scheduleDaysToTimetable(schedule.ScheduleDays))
+	data.MonSun = model.MonSun
+	data.MonFri = model.MonFri
+	data.Mon = model.Mon
+	data.Tue = model.Tue
+	data.Wed = model.Wed
+	data.Thu = model.Thu
+	data.Fri = model.Fri
+	data.Sat = model.Sat
+	data.Sun = model.Sun
+	data.TemperatureUnit = model.TemperatureUnit
+	data.Days = heatingScheduleToDaysModel(ctx, schedule, temperatureUnit(data.TemperatureUnit, home))
+	analytics, diags := heatingScheduleAnalytics(ctx, data.Days)
+	data.Analytics = analytics
+	return diags
+}
+
+// scheduleDaysToTimetable returns the name of the timetable used by the given
+// schedule days.
+func scheduleDaysToTimetable(scheduleDays gotado.ScheduleDays) string {
+	switch scheduleDays {
+	case gotado.ScheduleDaysMonToSun:
+		return timetableMonSun
+	case gotado.ScheduleDaysMonToFriSatSun:
+		return timetableMonFriSatSun
+	case gotado.ScheduleDaysMonTueWedThuFriSatSun:
+		return timetableAllDays
+	}
+	return ""
+}
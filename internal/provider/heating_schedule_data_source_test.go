@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHeatingScheduleToDataSourceModel(t *testing.T) {
+	block := func(dayType gotado.DayType) *gotado.ScheduleTimeBlock {
+		return &gotado.ScheduleTimeBlock{
+			DayType: dayType,
+			Start:   "00:00",
+			End:     "00:00",
+			Setting: &gotado.ZoneSetting{Power: gotado.PowerOn, Temperature: &gotado.ZoneSettingTemperature{Celsius: 20, Fahrenheit: 68}},
+		}
+	}
+
+	schedule := &gotado.HeatingSchedule{
+		ScheduleDays: gotado.ScheduleDaysMonToFriSatSun,
+		Blocks: []*gotado.ScheduleTimeBlock{
+			block(gotado.DayTypeMondayToFriday),
+			block(gotado.DayTypeSaturday),
+			block(gotado.DayTypeSunday),
+		},
+	}
+	home := &gotado.Home{ID: 1, Name: "My Home", TemperatureUnit: gotado.TemperatureUnitCelsius}
+	zone := &gotado.Zone{ID: 2, Name: "Living Room"}
+	data := HeatingScheduleDataSourceModel{
+		HomeID: types.Int64Value(1),
+		ZoneID: types.Int64Value(2),
+	}
+
+	heatingScheduleToDataSourceModel(context.Background(), home, zone, schedule, &data)
+
+	if data.ID.ValueString() != "1/2" {
+		t.Errorf("Expected ID '1/2', got: %s", data.ID)
+	}
+	if data.HomeName.ValueString() != "My Home" || data.ZoneName.ValueString() != "Living Room" {
+		t.Errorf("Expected names 'My Home' and 'Living Room', got: %s and %s", data.HomeName, data.ZoneName)
+	}
+	if data.Timetable.ValueString() != timetableMonFriSatSun {
+		t.Errorf("Expected timetable '%s', got: %s", timetableMonFriSatSun, data.Timetable)
+	}
+	if len(data.MonFri) != 1 || len(data.Sat) != 1 || len(data.Sun) != 1 {
+		t.Fatalf("Expected one block for mon_fri, sat and sun, got: %d, %d, %d", len(data.MonFri), len(data.Sat), len(data.Sun))
+	}
+	if data.MonSun != nil || data.Mon != nil {
+		t.Errorf("Expected blocks of other timetables to be null")
+	}
+	if data.MonFri[0].Temperature.ValueFloat64() != 20 {
+		t.Errorf("Expected temperature 20, got: %s", data.MonFri[0].Temperature)
+	}
+	if data.TemperatureUnit.ValueString() != string(gotado.TemperatureUnitCelsius) {
+		t.Errorf("Expected temperature unit '%s', got: %s", gotado.TemperatureUnitCelsius, data.TemperatureUnit)
+	}
+
+	data.TemperatureUnit = types.StringValue(string(gotado.TemperatureUnitFahrenheit))
+	heatingScheduleToDataSourceModel(context.Background(), home, zone, schedule, &data)
+
+	if data.MonFri[0].Temperature.ValueFloat64() != 68 {
+		t.Errorf("Expected temperature 68, got: %s", data.MonFri[0].Temperature)
+	}
+	if data.Days.Mon[0].Temperature.ValueFloat64() != 68 {
+		t.Errorf("Expected temperature 68 on Monday, got: %s", data.Days.Mon[0].Temperature)
+	}
+}
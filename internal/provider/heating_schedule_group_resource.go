@@ -0,0 +1,683 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &HeatingScheduleGroupResource{}
+var _ resource.ResourceWithImportState = &HeatingScheduleGroupResource{}
+var _ resource.ResourceWithModifyPlan = &HeatingScheduleGroupResource{}
+var _ resource.ResourceWithValidateConfig = &HeatingScheduleGroupResource{}
+
+func NewHeatingScheduleGroupResource() resource.Resource {
+	return &HeatingScheduleGroupResource{}
+}
+
+type HeatingScheduleGroupResource struct {
+	client      *gotado.Tado
+	convergence convergence
+}
+
+type HeatingScheduleGroupResourceModel struct {
+	ID        types.String     `tfsdk:"id"`
+	HomeID    types.Int64      `tfsdk:"home_id"`
+	HomeName  types.String     `tfsdk:"home_name"`
+	ZoneNames []types.String   `tfsdk:"zone_names"`
+	ZoneIDs   []types.Int64    `tfsdk:"zone_ids"`
+	ZoneType  types.String     `tfsdk:"zone_type"`
+	MonSun    []TimeBlockModel `tfsdk:"mon_sun"`
+	MonFri    []TimeBlockModel `tfsdk:"mon_fri"`
+	Mon       []TimeBlockModel `tfsdk:"mon"`
+	Tue       []TimeBlockModel `tfsdk:"tue"`
+	Wed       []TimeBlockModel `tfsdk:"wed"`
+	Thu       []TimeBlockModel `tfsdk:"thu"`
+	Fri       []TimeBlockModel `tfsdk:"fri"`
+	Sat       []TimeBlockModel `tfsdk:"sat"`
+	Sun       []TimeBlockModel `tfsdk:"sun"`
+
+	TemperatureUnit types.String   `tfsdk:"temperature_unit"`
+	ZoneSchedules   types.Map      `tfsdk:"zone_schedules"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (*HeatingScheduleGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_heating_schedule_group"
+}
+
+func (HeatingScheduleGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "One heating schedule applied to a group of zones. The members of the group are all zones listed in `zone_names` or `zone_ids`, plus all zones of type `zone_type`. The day attributes have the same format as in the `tado_heating_schedule` resource. Zones which are removed from the group keep their schedule.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of this heating schedule group resource.",
+				Computed:            true,
+			},
+			"home_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the home the zones of this group belong to. Either `home_id` or `home_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateForUnknownUnlessChanged(path.Root("home_name")),
+				},
+			},
+			"home_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the home the zones of this group belong to. Either `home_id` or `home_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("home_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					useStateForUnknownUnlessChanged(path.Root("home_id")),
+				},
+			},
+			"zone_names": schema.SetAttribute{
+				MarkdownDescription: "Names of the zones which are members of this group.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"zone_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the zones which are members of this group.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"zone_type": schema.StringAttribute{
+				MarkdownDescription: "Make all zones of this type members of this group. Only 'HEATING' zones support heating schedules.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(gotado.ZoneTypeHeating),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("zone_names"), path.MatchRoot("zone_ids")),
+				},
+			},
+			"mon_sun": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Monday - Sunday.",
+				Optional:            true,
+				NestedObject:        resetTimeBlockAttributes,
+			},
+			"mon_fri": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Monday - Friday.",
+				Optional:            true,
+				NestedObject:        resetTimeBlockAttributes,
+			},
+			"mon": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Monday.",
+				Optional:            true,
+				NestedObject:        resetTimeBlockAttributes,
+			},
+			"tue": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Tuesday.",
+				Optional:            true,
+				NestedObject:        resetTimeBlockAttributes,
+			},
+			"wed": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Wednesday.",
+				Optional:            true,
+				NestedObject:        resetTimeBlockAttributes,
+			},
+			"thu": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Thursday.",
+				Optional:            true,
+				NestedObject:        resetTimeBlockAttributes,
+			},
+			"fri": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Friday.",
+				Optional:            true,
+				NestedObject:        resetTimeBlockAttributes,
+			},
+			"sat": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Saturday.",
+				Optional:            true,
+				NestedObject:        resetTimeBlockAttributes,
+			},
+			"sun": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Sunday.",
+				Optional:            true,
+				NestedObject:        resetTimeBlockAttributes,
+			},
+			"temperature_unit": schema.StringAttribute{
+				MarkdownDescription: "Unit of the temperatures of the schedule. Either 'CELSIUS' or 'FAHRENHEIT'. Defaults to the temperature unit of the home. Temperatures in another unit than the one of the home are converted and rounded to the steps in which tado sets temperatures.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(temperatureUnits...),
+				},
+				PlanModifiers: []planmodifier.String{
+					useStateForUnknownUnlessChanged(path.Root("home_id"), path.Root("home_name")),
+				},
+			},
+			"zone_schedules": schema.MapAttribute{
+				MarkdownDescription: "Summary of the schedule of each member zone in the temperature unit of the home, keyed by zone ID. Changes made to the schedule of a single zone outside of Terraform show up as a difference of its entry, even if the zone has been renamed.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *HeatingScheduleGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*tadoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *tadoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.newClient(ctx, &resp.Diagnostics)
+	r.convergence = data.convergence
+}
+
+func (r HeatingScheduleGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HeatingScheduleGroupResourceModel
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &data, nil)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r HeatingScheduleGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HeatingScheduleGroupResourceModel
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	me, err := r.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zones, err := home.GetZones(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get zones of home '%s': %v", home.Name, err))
+		return
+	}
+
+	// Only the zones the schedule has been applied to are read, by their ID so
+	// that renamed zones are kept. Zones which no longer exist are dropped
+	// from the state. After an import the members are unknown, so all zones
+	// which support heating schedules are read.
+	imported := data.ZoneSchedules.IsNull()
+	members := make([]*gotado.Zone, 0, len(zones))
+	zoneSchedules := make(map[string]string)
+	for _, zone := range zones {
+		if _, ok := data.ZoneSchedules.Elements()[heatingScheduleGroupZoneKey(zone)]; !ok && !(imported && zone.Type == gotado.ZoneTypeHeating) {
+			continue
+		}
+		schedule, err := zone.GetHeatingSchedule(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get heating schedule for zone '%s': %v", zone.Name, err))
+			return
+		}
+		members = append(members, zone)
+		zoneSchedules[heatingScheduleGroupZoneKey(zone)] = summarizeHeatingSchedule(schedule.Blocks, home.TemperatureUnit)
+	}
+	if data.ID.IsNull() {
+		data.ID = types.StringValue(heatingScheduleGroupID(home, members))
+	}
+	data.HomeID = types.Int64Value(int64(home.ID))
+	data.HomeName = types.StringValue(home.Name)
+	data.TemperatureUnit = types.StringValue(string(temperatureUnit(data.TemperatureUnit, home)))
+	data.ZoneSchedules, diags = types.MapValueFrom(ctx, types.StringType, zoneSchedules)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r HeatingScheduleGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state HeatingScheduleGroupResourceModel
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	current := make(map[string]string)
+	resp.Diagnostics.Append(state.ZoneSchedules.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(r.apply(ctx, &data, current)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (HeatingScheduleGroupResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// A schedule can't be deleted, so we simply 'forget' the group
+}
+
+func (HeatingScheduleGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	homeID, homeName := parseImportIdentifier(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("home_id"), homeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("home_name"), homeName)...)
+}
+
+func (HeatingScheduleGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateHeatingScheduleConfig(ctx, req.Config, scheduleDayAttributes)...)
+}
+
+func (r HeatingScheduleGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan if the resource is destroyed or the provider is not
+	// configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// The zone schedules can only be planned once all attributes they depend
+	// on are known.
+	var data HeatingScheduleGroupResourceModel
+	if diags := req.Plan.Get(ctx, &data); diags.HasError() || (data.HomeID.IsUnknown() && data.HomeName.IsUnknown()) {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	me, err := r.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkName(path.Root("home_name"), "Home", data.HomeName, home.Name)...)
+	zones, diags := getHeatingScheduleGroupZones(ctx, home, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unit := temperatureUnit(data.TemperatureUnit, home)
+	blocks := heatingScheduleGroupTimeBlocks(data)
+	schedule := timeBlockModelsToResourceModel(blocks)
+	schedule.TemperatureUnit = types.StringValue(string(unit))
+	zoneSchedules := make(map[string]string, len(zones))
+	for _, zone := range zones {
+		if hasTemperatures(blocks) {
+			capabilities, err := zone.GetCapabilities(ctx)
+			if err != nil {
+				resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get capabilities of zone '%s': %v", zone.Name, err))
+				return
+			}
+			resp.Diagnostics.Append(checkTimeBlockTemperatures(blocks, zone.Name, temperatureCapabilities(capabilities, unit))...)
+		}
+
+		heatingSchedule, diags := heatingScheduleResourceModelToObject(ctx, schedule, home, zone)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		zoneSchedules[heatingScheduleGroupZoneKey(zone)] = summarizeHeatingSchedule(heatingSchedule.Blocks, home.TemperatureUnit)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), heatingScheduleGroupID(home, zones))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("home_id"), int64(home.ID))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("home_name"), home.Name)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("temperature_unit"), string(unit))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("zone_schedules"), zoneSchedules)...)
+}
+
+// apply applies the schedule of the group to all member zones whose current
+// schedule, as recorded in the given zone schedules, differs from the
+// planned schedule. The computed attributes of data are updated accordingly.
+func (r HeatingScheduleGroupResource) apply(ctx context.Context, data *HeatingScheduleGroupResourceModel, current map[string]string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	me, err := r.client.Me(ctx)
+	if err != nil {
+		diags.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return diags
+	}
+
+	home, homeDiags := getHome(ctx, me, data.HomeID, data.HomeName)
+	diags.Append(homeDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(checkName(path.Root("home_name"), "Home", data.HomeName, home.Name)...)
+	zones, zoneDiags := getHeatingScheduleGroupZones(ctx, home, *data)
+	diags.Append(zoneDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	unit := temperatureUnit(data.TemperatureUnit, home)
+	schedule := timeBlockModelsToResourceModel(heatingScheduleGroupTimeBlocks(*data))
+	schedule.TemperatureUnit = types.StringValue(string(unit))
+	zoneSchedules := make(map[string]string, len(zones))
+	for _, zone := range zones {
+		heatingSchedule, scheduleDiags := heatingScheduleResourceModelToObject(ctx, schedule, home, zone)
+		diags.Append(scheduleDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		summary := summarizeHeatingSchedule(heatingSchedule.Blocks, home.TemperatureUnit)
+		zoneSchedules[heatingScheduleGroupZoneKey(zone)] = summary
+
+		// Zones which already have the desired schedule are not touched.
+		if previous, ok := current[heatingScheduleGroupZoneKey(zone)]; ok && previous == summary {
+			continue
+		}
+
+		if err := zone.SetHeatingSchedule(ctx, heatingSchedule); err != nil {
+			diags.AddError("Tado API Error", fmt.Sprintf("Unable to set heating schedule for zone '%s': %v", zone.Name, err))
+			return diags
+		}
+		_, scheduleDiags = waitForHeatingSchedule(ctx, r.convergence, home, zone, heatingSchedule, types.StringNull())
+		diags.Append(scheduleDiags...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	data.ID = types.StringValue(heatingScheduleGroupID(home, zones))
+	data.HomeID = types.Int64Value(int64(home.ID))
+	data.HomeName = types.StringValue(home.Name)
+	data.TemperatureUnit = types.StringValue(string(unit))
+	data.ZoneSchedules, zoneDiags = types.MapValueFrom(ctx, types.StringType, zoneSchedules)
+	diags.Append(zoneDiags...)
+	return diags
+}
+
+// heatingScheduleGroupTimeBlocks returns the time blocks of all day
+// attributes of a heating schedule group, keyed by attribute name.
+func heatingScheduleGroupTimeBlocks(data HeatingScheduleGroupResourceModel) map[string][]TimeBlockModel {
+	return map[string][]TimeBlockModel{
+		"mon_sun": data.MonSun,
+		"mon_fri": data.MonFri,
+		"mon":     data.Mon,
+		"tue":     data.Tue,
+		"wed":     data.Wed,
+		"thu":     data.Thu,
+		"fri":     data.Fri,
+		"sat":     data.Sat,
+		"sun":     data.Sun,
+	}
+}
+
+// getHeatingScheduleGroupZones returns the member zones of a heating schedule
+// group in the given home.
+func getHeatingScheduleGroupZones(ctx context.Context, home *gotado.Home, data HeatingScheduleGroupResourceModel) ([]*gotado.Zone, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	zones, err := home.GetZones(ctx)
+	if err != nil {
+		diags.AddError("Tado API Error", fmt.Sprintf("Unable to get zones of home '%s': %v", home.Name, err))
+		return nil, diags
+	}
+
+	names := make([]string, 0, len(data.ZoneNames))
+	for _, name := range data.ZoneNames {
+		names = append(names, name.ValueString())
+	}
+	ids := make([]int64, 0, len(data.ZoneIDs))
+	for _, id := range data.ZoneIDs {
+		ids = append(ids, id.ValueInt64())
+	}
+
+	members, err := selectHeatingScheduleGroupZones(zones, names, ids, data.ZoneType.ValueString())
+	if err != nil {
+		diags.AddError("Invalid Heating Schedule Group", fmt.Sprintf("Unable to determine the zones of home '%s': %v", home.Name, err))
+		return nil, diags
+	}
+
+	return members, diags
+}
+
+// selectHeatingScheduleGroupZones selects the zones with the given names or
+// IDs and all zones of the given type, sorted by name. An empty zone type
+// selects no zones. Names or IDs which don't match any zone and selected
+// zones which don't support heating schedules are an error.
+func selectHeatingScheduleGroupZones(zones []*gotado.Zone, names []string, ids []int64, zoneType string) ([]*gotado.Zone, error) {
+	selected := make(map[int32]*gotado.Zone)
+
+	for _, name := range names {
+		found := false
+		for _, zone := range zones {
+			if zone.Name == name {
+				selected[zone.ID] = zone
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown zone name '%s'", name)
+		}
+	}
+
+	for _, id := range ids {
+		found := false
+		for _, zone := range zones {
+			if int64(zone.ID) == id {
+				selected[zone.ID] = zone
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown zone ID %d", id)
+		}
+	}
+
+	if zoneType != "" {
+		for _, zone := range zones {
+			if string(zone.Type) == zoneType {
+				selected[zone.ID] = zone
+			}
+		}
+	}
+
+	members := make([]*gotado.Zone, 0, len(selected))
+	for _, zone := range selected {
+		if zone.Type != gotado.ZoneTypeHeating {
+			return nil, fmt.Errorf("zone '%s' is of type '%s', only '%s' zones support heating schedules", zone.Name, zone.Type, gotado.ZoneTypeHeating)
+		}
+		members = append(members, zone)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Name < members[j].Name })
+
+	return members, nil
+}
+
+// heatingScheduleGroupZoneKey returns the key of a zone in the zone
+// schedules of a heating schedule group.
+func heatingScheduleGroupZoneKey(zone *gotado.Zone) string {
+	return strconv.Itoa(int(zone.ID))
+}
+
+// heatingScheduleGroupID returns the ID of a heating schedule group, which is
+// made up of the home ID and the sorted IDs of the member zones.
+func heatingScheduleGroupID(home *gotado.Home, zones []*gotado.Zone) string {
+	ids := make([]int, len(zones))
+	for i, zone := range zones {
+		ids[i] = int(zone.ID)
+	}
+	sort.Ints(ids)
+	zoneIDs := make([]string, len(ids))
+	for i, id := range ids {
+		zoneIDs[i] = strconv.Itoa(id)
+	}
+	return fmt.Sprintf("%d/%s", home.ID, strings.Join(zoneIDs, ","))
+}
+
+// scheduleDayTypeNames maps the day types of tado schedules to the names of
+// the day attributes, in the order in which they are summarized.
+var scheduleDayTypeNames = []struct {
+	dayType gotado.DayType
+	name    string
+}{
+	{gotado.DayTypeMondayToSunday, "mon_sun"},
+	{gotado.DayTypeMondayToFriday, "mon_fri"},
+	{gotado.DayTypeMonday, "mon"},
+	{gotado.DayTypeTuesday, "tue"},
+	{gotado.DayTypeWednesday, "wed"},
+	{gotado.DayTypeThursday, "thu"},
+	{gotado.DayTypeFriday, "fri"},
+	{gotado.DayTypeSaturday, "sat"},
+	{gotado.DayTypeSunday, "sun"},
+}
+
+// summarizeHeatingSchedule returns a human readable, canonical summary of a
+// heating schedule, e.g.
+// "mon_sun: 00:00-06:00 off, 06:00-22:00 20.5°C, 22:00-00:00 off". Blocks
+// which are not controlled by geofencing are marked with "(no geofencing)".
+// Temperatures are given in the given unit.
+func summarizeHeatingSchedule(blocks []*gotado.ScheduleTimeBlock, unit gotado.TemperatureUnit) string {
+	sortedBlocks := sortTimeBlocksByDayType(blocks)
+
+	days := make([]string, 0)
+	for _, day := range scheduleDayTypeNames {
+		dayBlocks, ok := sortedBlocks[day.dayType]
+		if !ok {
+			continue
+		}
+		// Adjacent blocks with the same setting are merged, like tado does.
+		summaries := make([]string, 0, len(dayBlocks))
+		start := dayBlocks[0].Start
+		for i, block := range dayBlocks {
+			setting := summarizeTimeBlockSetting(block, unit)
+			if i+1 < len(dayBlocks) && setting == summarizeTimeBlockSetting(dayBlocks[i+1], unit) {
+				continue
+			}
+			summaries = append(summaries, fmt.Sprintf("%s-%s %s", start, normalizeEndOfBlock(block.End), setting))
+			if i+1 < len(dayBlocks) {
+				start = dayBlocks[i+1].Start
+			}
+		}
+		days = append(days, fmt.Sprintf("%s: %s", day.name, strings.Join(summaries, ", ")))
+	}
+
+	return strings.Join(days, "; ")
+}
+
+// summarizeTimeBlockSetting returns a human readable summary of the setting
+// of a single time block. Temperatures are rounded to tenths of a degree.
+func summarizeTimeBlockSetting(block *gotado.ScheduleTimeBlock, unit gotado.TemperatureUnit) string {
+	setting := "off"
+	if block.Setting != nil && block.Setting.Power == gotado.PowerOn {
+		setting = "on"
+		if temperature := block.Setting.Temperature; temperature != nil {
+			if unit == gotado.TemperatureUnitFahrenheit {
+				setting = strconv.FormatFloat(math.Round(temperature.Fahrenheit*10)/10, 'f', -1, 64) + "°F"
+			} else {
+				setting = strconv.FormatFloat(math.Round(temperature.Celsius*10)/10, 'f', -1, 64) + "°C"
+			}
+		}
+	}
+
+	if block.GeolocationOverride {
+		setting += " (no geofencing)"
+	}
+	return setting
+}
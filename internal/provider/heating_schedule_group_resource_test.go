@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/gonzolino/gotado/v2"
+)
+
+func TestSelectHeatingScheduleGroupZones(t *testing.T) {
+	zones := []*gotado.Zone{
+		{ID: 1, Name: "Meeting Room B", Type: gotado.ZoneTypeHeating},
+		{ID: 2, Name: "Meeting Room A", Type: gotado.ZoneTypeHeating},
+		{ID: 3, Name: "Kitchen", Type: gotado.ZoneTypeHeating},
+		{ID: 4, Name: "Hot Water", Type: gotado.ZoneTypeHotWater},
+	}
+
+	cases := map[string]struct {
+		names    []string
+		ids      []int64
+		zoneType string
+		expected []string
+		wantErr  bool
+	}{
+		"names": {
+			names:    []string{"Meeting Room B", "Meeting Room A"},
+			expected: []string{"Meeting Room A", "Meeting Room B"},
+		},
+		"names and ids": {
+			names:    []string{"Kitchen"},
+			ids:      []int64{1, 3},
+			expected: []string{"Kitchen", "Meeting Room B"},
+		},
+		"zone type": {
+			zoneType: gotado.ZoneTypeHeating,
+			expected: []string{"Kitchen", "Meeting Room A", "Meeting Room B"},
+		},
+		"unknown name": {
+			names:   []string{"Meeting Room C"},
+			wantErr: true,
+		},
+		"unknown id": {
+			ids:     []int64{5},
+			wantErr: true,
+		},
+		"hot water zone": {
+			names:   []string{"Hot Water"},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			members, err := selectHeatingScheduleGroupZones(zones, tc.names, tc.ids, tc.zoneType)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("Expected error, got members: %v", members)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if len(members) != len(tc.expected) {
+				t.Fatalf("Expected: %d members, got: %d", len(tc.expected), len(members))
+			}
+			for i, member := range members {
+				if member.Name != tc.expected[i] {
+					t.Errorf("Expected: member %d to be '%s', got: '%s'", i, tc.expected[i], member.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestSummarizeHeatingSchedule(t *testing.T) {
+	block := func(dayType gotado.DayType, start, end string, power gotado.Power, celsius, fahrenheit float64) *gotado.ScheduleTimeBlock {
+		block := &gotado.ScheduleTimeBlock{
+			DayType: dayType,
+			Start:   start,
+			End:     end,
+			Setting: &gotado.ZoneSetting{Power: power},
+		}
+		if power == gotado.PowerOn {
+			block.Setting.Temperature = &gotado.ZoneSettingTemperature{Celsius: celsius, Fahrenheit: fahrenheit}
+		}
+		return block
+	}
+
+	blocks := []*gotado.ScheduleTimeBlock{
+		block(gotado.DayTypeSunday, "00:00", "00:00", gotado.PowerOff, 0, 0),
+		block(gotado.DayTypeMondayToFriday, "00:00", "07:30", gotado.PowerOff, 0, 0),
+		block(gotado.DayTypeMondayToFriday, "07:30", "00:00", gotado.PowerOn, 20.5, 69),
+		block(gotado.DayTypeSaturday, "00:00", "00:00", gotado.PowerOn, 18, 64),
+	}
+	blocks[2].GeolocationOverride = true
+
+	merged := []*gotado.ScheduleTimeBlock{
+		block(gotado.DayTypeMondayToSunday, "00:00", "06:00", gotado.PowerOff, 0, 0),
+		block(gotado.DayTypeMondayToSunday, "06:00", "12:00", gotado.PowerOn, 20.04, 68),
+		block(gotado.DayTypeMondayToSunday, "12:00", "18:00", gotado.PowerOn, 20, 68),
+		block(gotado.DayTypeMondayToSunday, "18:00", "00:00", gotado.PowerOff, 0, 0),
+	}
+
+	cases := map[string]struct {
+		blocks   []*gotado.ScheduleTimeBlock
+		unit     gotado.TemperatureUnit
+		expected string
+	}{
+		"celsius": {
+			blocks:   blocks,
+			unit:     gotado.TemperatureUnitCelsius,
+			expected: "mon_fri: 00:00-07:30 off, 07:30-00:00 20.5°C (no geofencing); sat: 00:00-00:00 18°C; sun: 00:00-00:00 off",
+		},
+		"fahrenheit": {
+			blocks:   blocks,
+			unit:     gotado.TemperatureUnitFahrenheit,
+			expected: "mon_fri: 00:00-07:30 off, 07:30-00:00 69°F (no geofencing); sat: 00:00-00:00 64°F; sun: 00:00-00:00 off",
+		},
+		"merged": {
+			blocks:   merged,
+			unit:     gotado.TemperatureUnitCelsius,
+			expected: "mon_sun: 00:00-06:00 off, 06:00-18:00 20°C, 18:00-00:00 off",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			summary := summarizeHeatingSchedule(tc.blocks, tc.unit)
+			if summary != tc.expected {
+				t.Fatalf("Expected: %s, got: %s", tc.expected, summary)
+			}
+		})
+	}
+}
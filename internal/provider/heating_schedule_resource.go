@@ -2,27 +2,53 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &HeatingScheduleResource{}
 var _ resource.ResourceWithImportState = &HeatingScheduleResource{}
+var _ resource.ResourceWithModifyPlan = &HeatingScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &HeatingScheduleResource{}
+
+const (
+	onDestroyForget  = "forget"
+	onDestroyRestore = "restore"
+	onDestroyReset   = "reset"
+
+	// originalScheduleKey is the private state key under which the schedule of
+	// a zone is stored before it is overwritten by the resource.
+	originalScheduleKey = "original_schedule"
+)
 
 func NewHeatingScheduleResource() resource.Resource {
 	return &HeatingScheduleResource{}
 }
 
 type HeatingScheduleResource struct {
-	client *gotado.Tado
+	client      *gotado.Tado
+	convergence convergence
 }
 
 type TimeBlockModel struct {
@@ -35,7 +61,9 @@ type TimeBlockModel struct {
 
 type HeatingScheduleResourceModel struct {
 	ID       types.String     `tfsdk:"id"`
+	HomeID   types.Int64      `tfsdk:"home_id"`
 	HomeName types.String     `tfsdk:"home_name"`
+	ZoneID   types.Int64      `tfsdk:"zone_id"`
 	ZoneName types.String     `tfsdk:"zone_name"`
 	MonSun   []TimeBlockModel `tfsdk:"mon_sun"`
 	MonFri   []TimeBlockModel `tfsdk:"mon_fri"`
@@ -46,6 +74,80 @@ type HeatingScheduleResourceModel struct {
 	Fri      []TimeBlockModel `tfsdk:"fri"`
 	Sat      []TimeBlockModel `tfsdk:"sat"`
 	Sun      []TimeBlockModel `tfsdk:"sun"`
+
+	TemperatureUnit types.String `tfsdk:"temperature_unit"`
+
+	Days *HeatingScheduleDaysModel `tfsdk:"days"`
+
+	Timetables      *HeatingScheduleTimetablesModel `tfsdk:"timetables"`
+	ActiveTimetable types.String                    `tfsdk:"active_timetable"`
+
+	Analytics types.Object `tfsdk:"analytics"`
+
+	OnDestroy     types.String     `tfsdk:"on_destroy"`
+	ResetSchedule []TimeBlockModel `tfsdk:"reset_schedule"`
+	Timeouts      timeouts.Value   `tfsdk:"timeouts"`
+}
+
+// HeatingScheduleDaysModel describes a heating schedule day by day, regardless
+// of the timetable used by tado.
+type HeatingScheduleDaysModel struct {
+	Mon []TimeBlockModel `tfsdk:"mon"`
+	Tue []TimeBlockModel `tfsdk:"tue"`
+	Wed []TimeBlockModel `tfsdk:"wed"`
+	Thu []TimeBlockModel `tfsdk:"thu"`
+	Fri []TimeBlockModel `tfsdk:"fri"`
+	Sat []TimeBlockModel `tfsdk:"sat"`
+	Sun []TimeBlockModel `tfsdk:"sun"`
+}
+
+// HeatingScheduleTimetablesModel holds the schedules of each of the timetables
+// of tado. Timetables which are nil are not managed.
+type HeatingScheduleTimetablesModel struct {
+	MonSun       []TimeBlockModel                  `tfsdk:"mon_sun"`
+	MonFriSatSun *HeatingScheduleMonFriSatSunModel `tfsdk:"mon_fri_sat_sun"`
+	AllDays      *HeatingScheduleDaysModel         `tfsdk:"all_days"`
+}
+
+// HeatingScheduleMonFriSatSunModel holds the schedules of the Monday - Friday,
+// Saturday, Sunday timetable.
+type HeatingScheduleMonFriSatSunModel struct {
+	MonFri []TimeBlockModel `tfsdk:"mon_fri"`
+	Sat    []TimeBlockModel `tfsdk:"sat"`
+	Sun    []TimeBlockModel `tfsdk:"sun"`
+}
+
+// heatingScheduleSnapshot holds a heating schedule as stored in private state.
+type heatingScheduleSnapshot struct {
+	ScheduleDays gotado.ScheduleDays         `json:"schedule_days"`
+	Blocks       []*gotado.ScheduleTimeBlock `json:"blocks"`
+}
+
+// scheduleDayAttributes lists the names of all schedule attributes holding
+// time blocks.
+var scheduleDayAttributes = []string{"mon_sun", "mon_fri", "mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+// daysAttributes lists the names of the attributes of 'days' holding time
+// blocks, in the form understood by timeBlockListPath.
+var daysAttributes = []string{"days.mon", "days.tue", "days.wed", "days.thu", "days.fri", "days.sat", "days.sun"}
+
+// timetablesAttributes lists the names of the attributes of 'timetables'
+// holding time blocks, in the form understood by timeBlockListPath.
+var timetablesAttributes = []string{
+	"timetables.mon_sun",
+	"timetables.mon_fri_sat_sun.mon_fri", "timetables.mon_fri_sat_sun.sat", "timetables.mon_fri_sat_sun.sun",
+	"timetables.all_days.mon", "timetables.all_days.tue", "timetables.all_days.wed", "timetables.all_days.thu", "timetables.all_days.fri", "timetables.all_days.sat", "timetables.all_days.sun",
+}
+
+// timeBlockListAttributes lists the names of all attributes holding time
+// blocks, including those which are not part of the managed schedule.
+var timeBlockListAttributes = append(append(append(append([]string{}, scheduleDayAttributes...), daysAttributes...), timetablesAttributes...), "reset_schedule")
+
+// timetableIDs maps the names of the timetables to their IDs in tado.
+var timetableIDs = map[string]int32{
+	timetableMonSun:       0,
+	timetableMonFriSatSun: 1,
+	timetableAllDays:      2,
 }
 
 var timeBlockAttributes = schema.NestedAttributeObject{
@@ -55,7 +157,7 @@ var timeBlockAttributes = schema.NestedAttributeObject{
 			Required:            true,
 		},
 		"temperature": schema.Float64Attribute{
-			MarkdownDescription: "The temperature to set the heating to. Required when 'heating' is true",
+			MarkdownDescription: "The temperature to set the heating to, in the unit given by 'temperature_unit'. Required when 'heating' is true",
 			Optional:            true,
 		},
 		"start": schema.StringAttribute{
@@ -63,13 +165,77 @@ var timeBlockAttributes = schema.NestedAttributeObject{
 			Required:            true,
 		},
 		"end": schema.StringAttribute{
-			MarkdownDescription: "When the timeblock ends. Format must be 'hh:mm'.",
+			MarkdownDescription: "When the timeblock ends. Format must be 'hh:mm'. Both '00:00' and '24:00' mean the end of the day.",
 			Required:            true,
 		},
 		"geofencing_control": schema.BoolAttribute{
 			MarkdownDescription: "Whether the settings of this time block are overwritten by the tado away settings. Defaults to 'true'.",
 			Optional:            true,
 			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+	},
+}
+
+// dayAttributes are the attributes of a schedule which is described day by
+// day.
+var dayAttributes = map[string]schema.Attribute{
+	"mon": schema.ListNestedAttribute{
+		MarkdownDescription: "Schedule for Monday.",
+		Required:            true,
+		CustomType:          newTimeBlockListType(),
+		NestedObject:        timeBlockAttributes,
+	},
+	"tue": schema.ListNestedAttribute{
+		MarkdownDescription: "Schedule for Tuesday.",
+		Required:            true,
+		CustomType:          newTimeBlockListType(),
+		NestedObject:        timeBlockAttributes,
+	},
+	"wed": schema.ListNestedAttribute{
+		MarkdownDescription: "Schedule for Wednesday.",
+		Required:            true,
+		CustomType:          newTimeBlockListType(),
+		NestedObject:        timeBlockAttributes,
+	},
+	"thu": schema.ListNestedAttribute{
+		MarkdownDescription: "Schedule for Thursday.",
+		Required:            true,
+		CustomType:          newTimeBlockListType(),
+		NestedObject:        timeBlockAttributes,
+	},
+	"fri": schema.ListNestedAttribute{
+		MarkdownDescription: "Schedule for Friday.",
+		Required:            true,
+		CustomType:          newTimeBlockListType(),
+		NestedObject:        timeBlockAttributes,
+	},
+	"sat": schema.ListNestedAttribute{
+		MarkdownDescription: "Schedule for Saturday.",
+		Required:            true,
+		CustomType:          newTimeBlockListType(),
+		NestedObject:        timeBlockAttributes,
+	},
+	"sun": schema.ListNestedAttribute{
+		MarkdownDescription: "Schedule for Sunday.",
+		Required:            true,
+		CustomType:          newTimeBlockListType(),
+		NestedObject:        timeBlockAttributes,
+	},
+}
+
+// resetTimeBlockAttributes are the attributes of time blocks which are never
+// read back from tado, such as the blocks of the reset schedule. Other than in
+// the managed schedule, geofencing_control is not computed.
+var resetTimeBlockAttributes = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"heating":     timeBlockAttributes.Attributes["heating"],
+		"temperature": timeBlockAttributes.Attributes["temperature"],
+		"start":       timeBlockAttributes.Attributes["start"],
+		"end":         timeBlockAttributes.Attributes["end"],
+		"geofencing_control": schema.BoolAttribute{
+			MarkdownDescription: "Whether the settings of this time block are overwritten by the tado away settings. Defaults to 'true'.",
+			Optional:            true,
 		},
 	},
 }
@@ -78,7 +244,7 @@ func (*HeatingScheduleResource) Metadata(_ context.Context, req resource.Metadat
 	resp.TypeName = req.ProviderTypeName + "_heating_schedule"
 }
 
-func (HeatingScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (HeatingScheduleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The heating schedule of a zone.",
 
@@ -87,59 +253,214 @@ func (HeatingScheduleResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: "ID of this heating schedule resource.",
 				Computed:            true,
 			},
+			"home_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the home this heating schedule resource belongs to. Either `home_id` or `home_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateForUnknownUnlessChanged(path.Root("home_name")),
+				},
+			},
 			"home_name": schema.StringAttribute{
-				MarkdownDescription: "Name of the home this heating schedule resource belongs to.",
-				Required:            true,
+				MarkdownDescription: "Name of the home this heating schedule resource belongs to. Either `home_id` or `home_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("home_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					useStateForUnknownUnlessChanged(path.Root("home_id")),
+				},
+			},
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone of this heating schedule. Either `zone_id` or `zone_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					useStateForUnknownUnlessChanged(path.Root("home_id"), path.Root("home_name"), path.Root("zone_name")),
+				},
 			},
 			"zone_name": schema.StringAttribute{
-				MarkdownDescription: "Name of the zone of this heating schedule.",
-				Required:            true,
+				MarkdownDescription: "Name of the zone of this heating schedule. Either `zone_id` or `zone_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("zone_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					useStateForUnknownUnlessChanged(path.Root("home_id"), path.Root("home_name"), path.Root("zone_id")),
+				},
+			},
+			"temperature_unit": schema.StringAttribute{
+				MarkdownDescription: "Unit of the temperatures of the schedule. Either 'CELSIUS' or 'FAHRENHEIT'. Defaults to the temperature unit of the home. Temperatures in another unit than the one of the home are converted, so they must be multiples of the step in which tado sets temperatures in that unit, e.g. Celsius temperatures which are whole degrees Fahrenheit.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(temperatureUnits...),
+				},
+				PlanModifiers: []planmodifier.String{
+					useStateForUnknownUnlessChanged(path.Root("home_id"), path.Root("home_name")),
+				},
 			},
 			"mon_sun": schema.ListNestedAttribute{
 				MarkdownDescription: "Schedule for Monday - Sunday.",
 				Optional:            true,
+				CustomType:          newTimeBlockListType(),
 				NestedObject:        timeBlockAttributes,
 			},
 			"mon_fri": schema.ListNestedAttribute{
 				MarkdownDescription: "Schedule for Monday - Friday.",
 				Optional:            true,
+				CustomType:          newTimeBlockListType(),
 				NestedObject:        timeBlockAttributes,
 			},
 			"mon": schema.ListNestedAttribute{
 				MarkdownDescription: "Schedule for Monday.",
 				Optional:            true,
+				CustomType:          newTimeBlockListType(),
 				NestedObject:        timeBlockAttributes,
 			},
 			"tue": schema.ListNestedAttribute{
 				MarkdownDescription: "Schedule for Tuesday.",
 				Optional:            true,
+				CustomType:          newTimeBlockListType(),
 				NestedObject:        timeBlockAttributes,
 			},
 			"wed": schema.ListNestedAttribute{
 				MarkdownDescription: "Schedule for Wednesday.",
 				Optional:            true,
+				CustomType:          newTimeBlockListType(),
 				NestedObject:        timeBlockAttributes,
 			},
 			"thu": schema.ListNestedAttribute{
 				MarkdownDescription: "Schedule for Thursday.",
 				Optional:            true,
+				CustomType:          newTimeBlockListType(),
 				NestedObject:        timeBlockAttributes,
 			},
 			"fri": schema.ListNestedAttribute{
 				MarkdownDescription: "Schedule for Friday.",
 				Optional:            true,
+				CustomType:          newTimeBlockListType(),
 				NestedObject:        timeBlockAttributes,
 			},
 			"sat": schema.ListNestedAttribute{
 				MarkdownDescription: "Schedule for Saturday.",
 				Optional:            true,
+				CustomType:          newTimeBlockListType(),
 				NestedObject:        timeBlockAttributes,
 			},
 			"sun": schema.ListNestedAttribute{
 				MarkdownDescription: "Schedule for Sunday.",
 				Optional:            true,
+				CustomType:          newTimeBlockListType(),
 				NestedObject:        timeBlockAttributes,
 			},
+			"days": schema.SingleNestedAttribute{
+				MarkdownDescription: "Schedule for each day of the week. Unlike the other schedule attributes, 'days' always describes all seven days and the most compact timetable of tado which matches them is chosen automatically. Can't be combined with the other schedule attributes.",
+				Optional:            true,
+				Attributes:          dayAttributes,
+			},
+			"timetables": schema.SingleNestedAttribute{
+				MarkdownDescription: "Schedules for each of the timetables of tado. Unlike the other schedule attributes, any or all timetables can be managed at once and switching between them with 'active_timetable' keeps the time blocks of the inactive timetables. Can't be combined with the other schedule attributes.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"mon_sun": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Monday - Sunday.",
+						Optional:            true,
+						CustomType:          newTimeBlockListType(),
+						NestedObject:        timeBlockAttributes,
+						Validators: []validator.List{
+							listvalidator.AtLeastOneOf(
+								path.MatchRelative().AtParent().AtName(timetableMonFriSatSun),
+								path.MatchRelative().AtParent().AtName(timetableAllDays),
+							),
+						},
+					},
+					"mon_fri_sat_sun": schema.SingleNestedAttribute{
+						MarkdownDescription: "Schedules for Monday - Friday, Saturday and Sunday.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"mon_fri": schema.ListNestedAttribute{
+								MarkdownDescription: "Schedule for Monday - Friday.",
+								Required:            true,
+								CustomType:          newTimeBlockListType(),
+								NestedObject:        timeBlockAttributes,
+							},
+							"sat": dayAttributes["sat"],
+							"sun": dayAttributes["sun"],
+						},
+					},
+					"all_days": schema.SingleNestedAttribute{
+						MarkdownDescription: "Schedules for each day of the week.",
+						Optional:            true,
+						Attributes:          dayAttributes,
+					},
+				},
+			},
+			"active_timetable": schema.StringAttribute{
+				MarkdownDescription: "The active timetable of the zone. Can be one of 'mon_sun', 'mon_fri_sat_sun' or 'all_days'. Can only be set together with 'timetables'. If not set, the active timetable is left unchanged when using 'timetables' and follows from the schedule attributes otherwise.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(timetableMonSun, timetableMonFriSatSun, timetableAllDays),
+					stringvalidator.AlsoRequires(path.MatchRoot("timetables")),
+				},
+			},
+			"analytics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Figures about the active schedule of the zone, computed from the time blocks as tado stores them. Temperatures are in the unit given by `temperature_unit`.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"heating_hours": schema.Float64Attribute{
+						MarkdownDescription: "Number of hours per week during which heating is turned on.",
+						Computed:            true,
+					},
+					"degree_hours": schema.Float64Attribute{
+						MarkdownDescription: "Sum of the temperature of each time block which turns heating on, multiplied by its duration in hours, over the whole week.",
+						Computed:            true,
+					},
+					"min_temperature": schema.Float64Attribute{
+						MarkdownDescription: "Lowest temperature heating is set to. Null if heating is never turned on.",
+						Computed:            true,
+					},
+					"max_temperature": schema.Float64Attribute{
+						MarkdownDescription: "Highest temperature heating is set to. Null if heating is never turned on.",
+						Computed:            true,
+					},
+					"average_temperature": schema.Float64Attribute{
+						MarkdownDescription: "Average temperature heating is set to, weighted by the duration of the time blocks. Null if heating is never turned on.",
+						Computed:            true,
+					},
+					"switches_per_day": schema.MapAttribute{
+						MarkdownDescription: "Number of changes of the setting within each day, keyed by 'mon' to 'sun'.",
+						ElementType:         types.Int64Type,
+						Computed:            true,
+					},
+				},
+			},
+			"on_destroy": schema.StringAttribute{
+				MarkdownDescription: "What happens to the schedule of the zone when this resource is destroyed. Can be one of 'forget' (keep the managed schedule), 'restore' (restore the schedule the zone had before this resource was created) or 'reset' (apply 'reset_schedule'). Defaults to 'forget'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(onDestroyForget),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyForget, onDestroyRestore, onDestroyReset),
+				},
+			},
+			"reset_schedule": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Monday - Sunday which is applied when this resource is destroyed and 'on_destroy' is 'reset'. Defaults to heating turned off for the whole day, which leaves the zone in frost protection.",
+				Optional:            true,
+				NestedObject:        resetTimeBlockAttributes,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -161,58 +482,105 @@ func (r *HeatingScheduleResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	r.client = gotado.NewWithTokenRefreshCallback(ctx, data.config, data.token, createTokenUpdateCallback(data.tokenPath, &resp.Diagnostics))
+	r.client = data.newClient(ctx, &resp.Diagnostics)
+	r.convergence = data.convergence
 }
 
 func (r HeatingScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data HeatingScheduleResourceModel
 
-	diags := req.Config.Get(ctx, &data)
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	me, err := r.client.Me(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
 		return
 	}
 
-	homeName := data.HomeName.ValueString()
-	home, err := me.GetHome(ctx, homeName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get home '%s': %v", homeName, err))
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	zoneName := data.ZoneName.ValueString()
-	zone, err := home.GetZone(ctx, zoneName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get zone '%s': %v", zoneName, err))
+	zone, diags := getZone(ctx, home, data.ZoneID, data.ZoneName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	schedule, diags := heatingScheduleResourceModelToObject(ctx, data, zone)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(checkName(path.Root("home_name"), "Home", data.HomeName, home.Name)...)
+	resp.Diagnostics.Append(checkName(path.Root("zone_name"), "Zone", data.ZoneName, zone.Name)...)
+
+	// Managed timetables are written separately, as they are not limited to
+	// the active timetable.
+	var schedule *gotado.HeatingSchedule
+	if data.Timetables == nil {
+		schedule, diags = heatingScheduleResourceModelToObject(ctx, data, home, zone)
+		resp.Diagnostics.Append(diags...)
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := zone.SetHeatingSchedule(ctx, schedule); err != nil {
+	// Remember the current schedule of the zone, so that it can be restored
+	// when this resource is destroyed.
+	original, err := zone.GetHeatingSchedule(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get current heating schedule for zone '%s': %v", zone.Name, err))
+		return
+	}
+	snapshot, err := json.Marshal(heatingScheduleSnapshot{ScheduleDays: original.ScheduleDays, Blocks: original.Blocks})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to store heating schedule", fmt.Sprintf("Unable to encode current heating schedule for zone '%s': %v", zone.Name, err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, originalScheduleKey, snapshot)...)
+
+	if data.Timetables != nil {
+		resp.Diagnostics.Append(setHeatingScheduleTimetables(ctx, home, zone, data, nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if err := zone.SetHeatingSchedule(ctx, schedule); err != nil {
 		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to create heating schedule for zone '%s': %v", zone.Name, err))
 		return
 	}
 
-	schedule, err = zone.GetHeatingSchedule(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get created heating schedule for zone '%s': %v", zone.Name, err))
+	schedule, diags = waitForHeatingSchedule(ctx, r.convergence, home, zone, schedule, data.ActiveTimetable)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	heatingScheduleToResourceData(ctx, schedule, &data)
+	expected := data
+	heatingScheduleToResourceData(ctx, home, zone, schedule, &data)
+	keepEquivalentTimeBlocks(expected, &data)
+	if data.Timetables != nil {
+		data.Timetables, diags = getHeatingScheduleTimetables(ctx, zone, data.Timetables, temperatureUnit(data.TemperatureUnit, home))
+		resp.Diagnostics.Append(diags...)
+	}
+	data.Analytics, diags = heatingScheduleAnalytics(ctx, heatingScheduleToDaysModel(ctx, schedule, temperatureUnit(data.TemperatureUnit, home)))
+	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -227,23 +595,33 @@ func (r HeatingScheduleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	me, err := r.client.Me(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
 		return
 	}
 
-	homeName := data.HomeName.ValueString()
-	home, err := me.GetHome(ctx, homeName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get home '%s': %v", homeName, err))
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	zoneName := data.ZoneName.ValueString()
-	zone, err := home.GetZone(ctx, zoneName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get zone '%s': %v", zoneName, err))
+	zone, diags := getZone(ctx, home, data.ZoneID, data.ZoneName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -253,66 +631,108 @@ func (r HeatingScheduleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	heatingScheduleToResourceData(ctx, schedule, &data)
+	expected := data
+	heatingScheduleToResourceData(ctx, home, zone, schedule, &data)
+	keepEquivalentTimeBlocks(expected, &data)
+	if data.Timetables != nil {
+		data.Timetables, diags = getHeatingScheduleTimetables(ctx, zone, data.Timetables, temperatureUnit(data.TemperatureUnit, home))
+		resp.Diagnostics.Append(diags...)
+	}
+	data.Analytics, diags = heatingScheduleAnalytics(ctx, heatingScheduleToDaysModel(ctx, schedule, temperatureUnit(data.TemperatureUnit, home)))
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r HeatingScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data HeatingScheduleResourceModel
+	var data, prior HeatingScheduleResourceModel
 
 	diags := req.Plan.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &prior)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	me, err := r.client.Me(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
 		return
 	}
 
-	homeName := data.HomeName.ValueString()
-	home, err := me.GetHome(ctx, homeName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get home '%s': %v", homeName, err))
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	zoneName := data.ZoneName.ValueString()
-	zone, err := home.GetZone(ctx, zoneName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get zone '%s': %v", zoneName, err))
+	zone, diags := getZone(ctx, home, data.ZoneID, data.ZoneName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	schedule, diags := heatingScheduleResourceModelToObject(ctx, data, zone)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(checkName(path.Root("home_name"), "Home", data.HomeName, home.Name)...)
+	resp.Diagnostics.Append(checkName(path.Root("zone_name"), "Zone", data.ZoneName, zone.Name)...)
+
+	// Managed timetables are written separately, as they are not limited to
+	// the active timetable.
+	var schedule *gotado.HeatingSchedule
+	if data.Timetables == nil {
+		schedule, diags = heatingScheduleResourceModelToObject(ctx, data, home, zone)
+		resp.Diagnostics.Append(diags...)
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := zone.SetHeatingSchedule(ctx, schedule); err != nil {
+	if data.Timetables != nil {
+		resp.Diagnostics.Append(setHeatingScheduleTimetables(ctx, home, zone, data, &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if err := zone.SetHeatingSchedule(ctx, schedule); err != nil {
 		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to create heating schedule for zone '%s': %v", zone.Name, err))
 		return
 	}
 
-	schedule, err = zone.GetHeatingSchedule(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get created heating schedule for zone '%s': %v", zone.Name, err))
+	schedule, diags = waitForHeatingSchedule(ctx, r.convergence, home, zone, schedule, data.ActiveTimetable)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	heatingScheduleToResourceData(ctx, schedule, &data)
+	expected := data
+	heatingScheduleToResourceData(ctx, home, zone, schedule, &data)
+	keepEquivalentTimeBlocks(expected, &data)
+	if data.Timetables != nil {
+		data.Timetables, diags = getHeatingScheduleTimetables(ctx, zone, data.Timetables, temperatureUnit(data.TemperatureUnit, home))
+		resp.Diagnostics.Append(diags...)
+	}
+	data.Analytics, diags = heatingScheduleAnalytics(ctx, heatingScheduleToDaysModel(ctx, schedule, temperatureUnit(data.TemperatureUnit, home)))
+	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
-func (HeatingScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r HeatingScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data HeatingScheduleResourceModel
 
 	diags := req.State.Get(ctx, &data)
@@ -322,21 +742,575 @@ func (HeatingScheduleResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	// A schedule can't be deleted, so we simply 'forget' it
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	onDestroy := data.OnDestroy.ValueString()
+	if onDestroy != onDestroyRestore && onDestroy != onDestroyReset {
+		// A schedule can't be deleted, so we simply 'forget' it
+		return
+	}
+
+	me, err := r.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, diags := getZone(ctx, home, data.ZoneID, data.ZoneName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var schedule *gotado.HeatingSchedule
+	switch onDestroy {
+	case onDestroyRestore:
+		snapshot, diags := req.Private.GetKey(ctx, originalScheduleKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if snapshot == nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to restore heating schedule",
+				fmt.Sprintf("The original heating schedule of zone '%s' is unknown, e.g. because the resource has been imported. The current schedule is kept.", zone.Name),
+			)
+			return
+		}
+		schedule, diags = heatingScheduleSnapshotToObject(ctx, snapshot, zone)
+		resp.Diagnostics.Append(diags...)
+	case onDestroyReset:
+		resetSchedule := data.ResetSchedule
+		if resetSchedule == nil {
+			resetSchedule = []TimeBlockModel{{
+				Heating: types.BoolValue(false),
+				Start:   types.StringValue("00:00"),
+				End:     types.StringValue("00:00"),
+			}}
+		}
+		schedule, diags = heatingScheduleResourceModelToObject(ctx, HeatingScheduleResourceModel{MonSun: resetSchedule, TemperatureUnit: data.TemperatureUnit}, home, zone)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := zone.SetHeatingSchedule(ctx, schedule); err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to %s heating schedule for zone '%s': %v", onDestroy, zone.Name, err))
+		return
+	}
+}
+
+func (HeatingScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(validateHeatingScheduleConfig(ctx, req.Config, timeBlockListAttributes)...)
+}
+
+func (r HeatingScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan if the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	blocks, diags := getTimeBlockModels(ctx, req.Plan.GetAttribute, timeBlockListAttributes)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(planActiveTimetable(ctx, req, resp, blocks)...)
+	resp.Diagnostics.Append(planHeatingScheduleAnalytics(ctx, resp, blocks)...)
+
+	// Nothing to check if the provider is not configured yet.
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	// Changes of an existing schedule are summarized once the zone is known.
+	var prior, planned *HeatingScheduleDaysModel
+	if !req.State.Raw.IsNull() {
+		prior, planned, diags = heatingScheduleWeeks(ctx, req, resp, blocks)
+		resp.Diagnostics.Append(diags...)
+	}
+	// The unit is only known once the home is read, so the days are compared
+	// in the finer Celsius step here. The warning compares them in the unit.
+	changed := prior != nil && planned != nil && !equalDaysModels(*prior, *planned, gotado.TemperatureUnitCelsius)
+
+	var homeID, zoneID types.Int64
+	var homeName, zoneName, configuredUnit types.String
+	var timeoutsValue timeouts.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("home_id"), &homeID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("home_name"), &homeName)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone_id"), &zoneID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone_name"), &zoneName)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("temperature_unit"), &configuredUnit)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &timeoutsValue)...)
+
+	if resp.Diagnostics.HasError() || (homeID.IsUnknown() && homeName.IsUnknown()) || (zoneID.IsUnknown() && zoneName.IsUnknown()) || (!hasTemperatures(blocks) && !changed) {
+		return
+	}
+
+	readTimeout, diags := timeoutsValue.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	me, err := r.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, homeID, homeName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, diags := getZone(ctx, home, zoneID, zoneName)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unit := temperatureUnit(configuredUnit, home)
+	if configuredUnit.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("temperature_unit"), string(unit))...)
+	}
+
+	if changed {
+		resp.Diagnostics.Append(heatingScheduleChangesWarning(zone.Name, *prior, *planned, unit, time.Now().In(homeLocation(home)))...)
+	}
+
+	if !hasTemperatures(blocks) {
+		return
+	}
+
+	capabilities, err := zone.GetCapabilities(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get capabilities of zone '%s': %v", zone.Name, err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkTimeBlockTemperatures(blocks, zone.Name, temperatureCapabilities(capabilities, unit))...)
+	resp.Diagnostics.Append(checkTimeBlockConversions(blocks, unit, home.TemperatureUnit)...)
 }
 
 func (HeatingScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	splittedID := strings.Split(req.ID, "/")
 	if len(splittedID) != 2 {
-		resp.Diagnostics.AddError("Resource Import ID invalid", fmt.Sprintf("ID '%s' should be in format 'home_name/zone_name'", req.ID))
+		resp.Diagnostics.AddError("Resource Import ID invalid", fmt.Sprintf("ID '%s' should be in format 'home/zone', where home and zone are either names or numeric IDs", req.ID))
 		return
 	}
 
-	homeName, zoneName := splittedID[0], splittedID[1]
+	homeID, homeName := parseImportIdentifier(splittedID[0])
+	zoneID, zoneName := parseImportIdentifier(splittedID[1])
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("home_id"), homeID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("home_name"), homeName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_name"), zoneName)...)
 }
 
+// planActiveTimetable plans the active timetable if it is not configured. When
+// managing timetables, the active timetable is left unchanged. Otherwise it
+// follows from the planned schedule. A schedule described day by day keeps the
+// active timetable as long as the days are unchanged, so that the grouping
+// chosen in the tado app doesn't cause a diff.
+func planActiveTimetable(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, blocks map[string][]TimeBlockModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	var configured, prior, unit types.String
+	var timetables types.Object
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("active_timetable"), &configured)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("timetables"), &timetables)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("temperature_unit"), &unit)...)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("active_timetable"), &prior)...)
+	}
+	if diags.HasError() || !configured.IsNull() {
+		return diags
+	}
+
+	planned := types.StringUnknown()
+	data := timeBlockModelsToResourceModel(blocks)
+	switch {
+	case timetables.IsUnknown():
+	case !timetables.IsNull():
+		if !prior.IsNull() {
+			planned = prior
+		}
+	case data.Days != nil:
+		if !isCompleteDaysModel(*data.Days) {
+			break
+		}
+		if !prior.IsNull() {
+			priorBlocks, priorDiags := getTimeBlockModels(ctx, req.State.GetAttribute, daysAttributes)
+			diags.Append(priorDiags...)
+			if priorDays := timeBlockModelsToDaysModel(priorBlocks, "days."); priorDays != nil && equalDaysModels(*data.Days, *priorDays, gotado.TemperatureUnit(unit.ValueString())) {
+				planned = prior
+				break
+			}
+		}
+		planned = types.StringValue(heatingScheduleModelTimetable(compactHeatingScheduleDays(*data.Days, gotado.TemperatureUnit(unit.ValueString()))))
+	default:
+		if timetable := heatingScheduleModelTimetable(data); timetable != "" {
+			planned = types.StringValue(timetable)
+		}
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("active_timetable"), planned)...)
+	return diags
+}
+
+// planHeatingScheduleAnalytics plans the analytics of the planned schedule if
+// they are expected to change, so that they can be checked before the schedule
+// is applied. They stay unknown if the active schedule is not known yet.
+func planHeatingScheduleAnalytics(ctx context.Context, resp *resource.ModifyPlanResponse, blocks map[string][]TimeBlockModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	var analytics types.Object
+	var active types.String
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("analytics"), &analytics)...)
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("active_timetable"), &active)...)
+	if diags.HasError() || !analytics.IsUnknown() {
+		return diags
+	}
+
+	data := timeBlockModelsToResourceModel(blocks)
+	data.ActiveTimetable = active
+	planned, plannedDiags := heatingScheduleAnalytics(ctx, heatingScheduleModelToDaysModel(data))
+	diags.Append(plannedDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("analytics"), planned)...)
+	return diags
+}
+
+// heatingScheduleWeeks returns the active schedule of each day of the week in
+// the prior state and in the plan. Either is nil if it is not known, and both
+// are nil if the temperature unit changes.
+func heatingScheduleWeeks(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, blocks map[string][]TimeBlockModel) (*HeatingScheduleDaysModel, *HeatingScheduleDaysModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	var priorActive, plannedActive, priorUnit, plannedUnit types.String
+	diags.Append(req.State.GetAttribute(ctx, path.Root("active_timetable"), &priorActive)...)
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("active_timetable"), &plannedActive)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("temperature_unit"), &priorUnit)...)
+	diags.Append(resp.Plan.GetAttribute(ctx, path.Root("temperature_unit"), &plannedUnit)...)
+	priorBlocks, priorDiags := getTimeBlockModels(ctx, req.State.GetAttribute, timeBlockListAttributes)
+	diags.Append(priorDiags...)
+	if diags.HasError() || !priorUnit.Equal(plannedUnit) {
+		return nil, nil, diags
+	}
+
+	prior := timeBlockModelsToResourceModel(priorBlocks)
+	prior.ActiveTimetable = priorActive
+	planned := timeBlockModelsToResourceModel(blocks)
+	planned.ActiveTimetable = plannedActive
+	return heatingScheduleModelToDaysModel(prior), heatingScheduleModelToDaysModel(planned), diags
+}
+
+// heatingScheduleModelTimetable returns the name of the timetable described
+// by the day attributes of the heating schedule, or an empty string if they
+// don't describe a valid timetable.
+func heatingScheduleModelTimetable(data HeatingScheduleResourceModel) string {
+	switch {
+	case isMonSunSchedule(data):
+		return timetableMonSun
+	case isMonFriSatSunSchedule(data):
+		return timetableMonFriSatSun
+	case isMonTueWedThuFriSatSunSchedule(data):
+		return timetableAllDays
+	}
+	return ""
+}
+
+// validateHeatingScheduleConfig validates the time blocks of the given time
+// block attributes of a config and checks that the day attributes describe
+// exactly one of the timetables supported by tado.
+func validateHeatingScheduleConfig(ctx context.Context, config tfsdk.Config, names []string) diag.Diagnostics {
+	blocks, diags := getTimeBlockModels(ctx, config.GetAttribute, names)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, name := range names {
+		if models, ok := blocks[name]; ok {
+			diags.Append(validateTimeBlocks(timeBlockListPath(name), models)...)
+		}
+	}
+
+	allKnown := true
+	for _, name := range names {
+		// The reset schedule is not part of the managed schedule.
+		if name == "reset_schedule" {
+			continue
+		}
+		list, listDiags := getTimeBlockList(ctx, config.GetAttribute, name)
+		diags.Append(listDiags...)
+		if list.IsUnknown() {
+			allKnown = false
+		}
+	}
+
+	// The combination of days can only be checked once all of them are known.
+	if !allKnown {
+		return diags
+	}
+
+	data := timeBlockModelsToResourceModel(blocks)
+	if data.Timetables == nil && slices.Contains(names, timetablesAttributes[0]) {
+		// 'timetables' may be set without any of its time blocks.
+		var timetables types.Object
+		diags.Append(config.GetAttribute(ctx, path.Root("timetables"), &timetables)...)
+		if !timetables.IsNull() {
+			data.Timetables = &HeatingScheduleTimetablesModel{}
+		}
+	}
+	if data.Timetables != nil {
+		if data.Days != nil || hasScheduleDayAttributes(data) {
+			diags.AddAttributeError(
+				path.Root("timetables"),
+				"Invalid Heating Schedule",
+				"'timetables' can't be combined with 'days', 'mon_sun', 'mon_fri', 'mon', 'tue', 'wed', 'thu', 'fri', 'sat' or 'sun'.",
+			)
+		}
+		return diags
+	}
+	if data.Days != nil {
+		if hasScheduleDayAttributes(data) {
+			diags.AddAttributeError(
+				path.Root("days"),
+				"Invalid Heating Schedule",
+				"'days' can't be combined with 'mon_sun', 'mon_fri', 'mon', 'tue', 'wed', 'thu', 'fri', 'sat' or 'sun'.",
+			)
+		}
+		return diags
+	}
+	if !isMonSunSchedule(data) && !isMonFriSatSunSchedule(data) && !isMonTueWedThuFriSatSunSchedule(data) {
+		diags.AddError(
+			"Invalid Heating Schedule",
+			"The heating schedule must either set 'mon_sun', or 'mon_fri', 'sat' and 'sun', or all of 'mon', 'tue', 'wed', 'thu', 'fri', 'sat' and 'sun'.",
+		)
+	}
+
+	return diags
+}
+
+// getTimeBlockModels reads the time blocks of the given time block attributes
+// using the getAttribute function of a config, plan or state. Unlike reading
+// the whole resource model, unknown values are tolerated: unknown lists are
+// omitted and unknown blocks have all their attributes set to unknown.
+func getTimeBlockModels(ctx context.Context, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics, names []string) (map[string][]TimeBlockModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	blocks := make(map[string][]TimeBlockModel)
+	for _, name := range names {
+		list, listDiags := getTimeBlockList(ctx, getAttribute, name)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if list.IsNull() || list.IsUnknown() {
+			continue
+		}
+
+		models := make([]TimeBlockModel, len(list.Elements()))
+		for i, element := range list.Elements() {
+			object, ok := element.(types.Object)
+			if !ok || object.IsUnknown() {
+				models[i] = TimeBlockModel{
+					Heating:           types.BoolUnknown(),
+					Temperature:       types.Float64Unknown(),
+					Start:             types.StringUnknown(),
+					End:               types.StringUnknown(),
+					GeofencingControl: types.BoolUnknown(),
+				}
+				continue
+			}
+			diags.Append(object.As(ctx, &models[i], basetypes.ObjectAsOptions{})...)
+		}
+		blocks[name] = models
+	}
+	return blocks, diags
+}
+
+// timeBlockListPath returns the path of a time block attribute. Nested
+// attributes are named like 'days.mon'.
+func timeBlockListPath(name string) path.Path {
+	names := strings.Split(name, ".")
+	p := path.Root(names[0])
+	for _, child := range names[1:] {
+		p = p.AtName(child)
+	}
+	return p
+}
+
+// getTimeBlockList reads a time block attribute as a list, regardless of
+// whether the attribute uses timeBlockListType.
+func getTimeBlockList(ctx context.Context, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics, name string) (types.List, diag.Diagnostics) {
+	var value attr.Value
+	diags := getAttribute(ctx, timeBlockListPath(name), &value)
+	if diags.HasError() {
+		return types.ListNull(timeBlockObjectType), diags
+	}
+	listValuable, ok := value.(basetypes.ListValuable)
+	if !ok {
+		diags.AddAttributeError(timeBlockListPath(name), "Unexpected Value Type", fmt.Sprintf("Expected a list of time blocks, got: %T. Please report this issue to the provider developers.", value))
+		return types.ListNull(timeBlockObjectType), diags
+	}
+	list, listDiags := listValuable.ToListValue(ctx)
+	diags.Append(listDiags...)
+	return list, diags
+}
+
+// timeBlockModelsToResourceModel returns a resource model with the day
+// attributes set to the given time blocks. 'days' is only set if any of its
+// attributes is given.
+func timeBlockModelsToResourceModel(blocks map[string][]TimeBlockModel) HeatingScheduleResourceModel {
+	data := HeatingScheduleResourceModel{
+		MonSun: blocks["mon_sun"],
+		MonFri: blocks["mon_fri"],
+		Mon:    blocks["mon"],
+		Tue:    blocks["tue"],
+		Wed:    blocks["wed"],
+		Thu:    blocks["thu"],
+		Fri:    blocks["fri"],
+		Sat:    blocks["sat"],
+		Sun:    blocks["sun"],
+	}
+	data.Days = timeBlockModelsToDaysModel(blocks, "days.")
+	for _, name := range timetablesAttributes {
+		if _, ok := blocks[name]; ok {
+			data.Timetables = &HeatingScheduleTimetablesModel{
+				MonSun:  blocks["timetables.mon_sun"],
+				AllDays: timeBlockModelsToDaysModel(blocks, "timetables.all_days."),
+			}
+			break
+		}
+	}
+	for _, name := range []string{"mon_fri", "sat", "sun"} {
+		if _, ok := blocks["timetables.mon_fri_sat_sun."+name]; ok {
+			data.Timetables.MonFriSatSun = &HeatingScheduleMonFriSatSunModel{
+				MonFri: blocks["timetables.mon_fri_sat_sun.mon_fri"],
+				Sat:    blocks["timetables.mon_fri_sat_sun.sat"],
+				Sun:    blocks["timetables.mon_fri_sat_sun.sun"],
+			}
+			break
+		}
+	}
+	return data
+}
+
+// timeBlockModelsToDaysModel returns the days of the given time blocks whose
+// names start with prefix, or nil if none of the days is given.
+func timeBlockModelsToDaysModel(blocks map[string][]TimeBlockModel, prefix string) *HeatingScheduleDaysModel {
+	for _, day := range []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"} {
+		if _, ok := blocks[prefix+day]; ok {
+			return &HeatingScheduleDaysModel{
+				Mon: blocks[prefix+"mon"],
+				Tue: blocks[prefix+"tue"],
+				Wed: blocks[prefix+"wed"],
+				Thu: blocks[prefix+"thu"],
+				Fri: blocks[prefix+"fri"],
+				Sat: blocks[prefix+"sat"],
+				Sun: blocks[prefix+"sun"],
+			}
+		}
+	}
+	return nil
+}
+
+// hasTemperatures checks if any of the given time blocks turns heating on to
+// a known temperature.
+func hasTemperatures(blocks map[string][]TimeBlockModel) bool {
+	for _, models := range blocks {
+		for _, model := range models {
+			if model.Heating.ValueBool() && !model.Temperature.IsNull() && !model.Temperature.IsUnknown() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkTimeBlockTemperatures checks the temperatures of all time blocks which
+// turn heating on against the temperature capabilities of a zone. An error
+// diagnostic is returned for each unsupported temperature.
+func checkTimeBlockTemperatures(blocks map[string][]TimeBlockModel, zoneName string, values *gotado.ZoneCapabilitiesTemperatureValues) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	for _, name := range timeBlockListAttributes {
+		for i, model := range blocks[name] {
+			if !model.Heating.ValueBool() || model.Temperature.IsNull() || model.Temperature.IsUnknown() {
+				continue
+			}
+			if err := checkTemperatureCapabilities(model.Temperature.ValueFloat64(), values); err != nil {
+				diags.AddAttributeError(
+					timeBlockListPath(name).AtListIndex(i).AtName("temperature"),
+					"Unsupported Temperature",
+					fmt.Sprintf("The temperature is not supported by zone '%s': %v.", zoneName, err),
+				)
+			}
+		}
+	}
+	return diags
+}
+
+// checkTimeBlockConversions checks the temperatures of all time blocks which
+// turn heating on for conversion to the temperature unit of the home. An error
+// diagnostic is returned for each temperature which would be read back with a
+// different value.
+func checkTimeBlockConversions(blocks map[string][]TimeBlockModel, unit, homeUnit gotado.TemperatureUnit) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	for _, name := range timeBlockListAttributes {
+		for i, model := range blocks[name] {
+			if !model.Heating.ValueBool() || model.Temperature.IsNull() || model.Temperature.IsUnknown() {
+				continue
+			}
+			if err := checkTemperatureConversion(model.Temperature.ValueFloat64(), unit, homeUnit); err != nil {
+				diags.AddAttributeError(
+					timeBlockListPath(name).AtListIndex(i).AtName("temperature"),
+					"Unsupported Temperature",
+					fmt.Sprintf("The temperature can't be converted to the temperature unit of the home without rounding: %v.", err),
+				)
+			}
+		}
+	}
+	return diags
+}
+
+// hasScheduleDayAttributes checks if any of the day attributes of the heating
+// schedule is set.
+func hasScheduleDayAttributes(data HeatingScheduleResourceModel) bool {
+	return data.MonSun != nil || data.MonFri != nil || data.Mon != nil || data.Tue != nil || data.Wed != nil || data.Thu != nil || data.Fri != nil || data.Sat != nil || data.Sun != nil
+}
+
 // isMonSunSchedule checks if the heating schedule has a valid Monday - Sunday schedule
 func isMonSunSchedule(data HeatingScheduleResourceModel) bool {
 	return data.MonSun != nil && data.MonFri == nil && data.Mon == nil && data.Tue == nil && data.Wed == nil && data.Thu == nil && data.Fri == nil && data.Sat == nil && data.Sun == nil
@@ -352,32 +1326,50 @@ func isMonTueWedThuFriSatSunSchedule(data HeatingScheduleResourceModel) bool {
 	return data.MonSun == nil && data.MonFri == nil && data.Mon != nil && data.Tue != nil && data.Wed != nil && data.Thu != nil && data.Fri != nil && data.Sat != nil && data.Sun != nil
 }
 
-func heatingScheduleToResourceData(ctx context.Context, schedule *gotado.HeatingSchedule, data *HeatingScheduleResourceModel) {
-	homeName, zoneName := data.HomeName.ValueString(), data.ZoneName.ValueString()
-	data.ID = types.StringValue(fmt.Sprintf("%s/%s", homeName, zoneName))
-	data.HomeName = types.StringValue(homeName)
-	data.ZoneName = types.StringValue(zoneName)
+func heatingScheduleToResourceData(ctx context.Context, home *gotado.Home, zone *gotado.Zone, schedule *gotado.HeatingSchedule, data *HeatingScheduleResourceModel) {
+	data.ID = types.StringValue(fmt.Sprintf("%d/%d", home.ID, zone.ID))
+	data.HomeID = types.Int64Value(int64(home.ID))
+	data.HomeName = types.StringValue(home.Name)
+	data.ZoneID = types.Int64Value(int64(zone.ID))
+	data.ZoneName = types.StringValue(zone.Name)
 
+	unit := temperatureUnit(data.TemperatureUnit, home)
+	data.TemperatureUnit = types.StringValue(string(unit))
+
+	data.ActiveTimetable = types.StringValue(scheduleDaysToTimetable(schedule.ScheduleDays))
 	sortedBlocks := sortTimeBlocksByDayType(schedule.Blocks)
 
+	// The time blocks of managed timetables are read separately, as they are
+	// not limited to the active timetable.
+	if data.Timetables != nil {
+		return
+	}
+
+	// If the schedule is described day by day, the timetable used by tado
+	// doesn't matter and the schedule is always expanded to all seven days.
+	if data.Days != nil {
+		data.Days = heatingScheduleToDaysModel(ctx, schedule, unit)
+		return
+	}
+
 	switch schedule.ScheduleDays {
 	case gotado.ScheduleDaysMonToSun:
 		data.MonSun = make([]TimeBlockModel, len(sortedBlocks[gotado.DayTypeMondayToSunday]))
 		for i, block := range sortedBlocks[gotado.DayTypeMondayToSunday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.MonSun[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.MonSun[i])
 		}
 	case gotado.ScheduleDaysMonToFriSatSun:
 		data.MonFri = make([]TimeBlockModel, len(sortedBlocks[gotado.DayTypeMondayToFriday]))
 		data.Sat = make([]TimeBlockModel, len(sortedBlocks[gotado.DayTypeSaturday]))
 		data.Sun = make([]TimeBlockModel, len(sortedBlocks[gotado.DayTypeSunday]))
 		for i, block := range sortedBlocks[gotado.DayTypeMondayToFriday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.MonFri[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.MonFri[i])
 		}
 		for i, block := range sortedBlocks[gotado.DayTypeSaturday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.Sat[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.Sat[i])
 		}
 		for i, block := range sortedBlocks[gotado.DayTypeSunday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.Sun[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.Sun[i])
 		}
 	case gotado.ScheduleDaysMonTueWedThuFriSatSun:
 		data.Mon = make([]TimeBlockModel, len(sortedBlocks[gotado.DayTypeMonday]))
@@ -388,180 +1380,405 @@ func heatingScheduleToResourceData(ctx context.Context, schedule *gotado.Heating
 		data.Sat = make([]TimeBlockModel, len(sortedBlocks[gotado.DayTypeSaturday]))
 		data.Sun = make([]TimeBlockModel, len(sortedBlocks[gotado.DayTypeSunday]))
 		for i, block := range sortedBlocks[gotado.DayTypeMonday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.Mon[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.Mon[i])
 		}
 		for i, block := range sortedBlocks[gotado.DayTypeTuesday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.Tue[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.Tue[i])
 		}
 		for i, block := range sortedBlocks[gotado.DayTypeWednesday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.Wed[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.Wed[i])
 		}
 		for i, block := range sortedBlocks[gotado.DayTypeThursday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.Thu[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.Thu[i])
 		}
 		for i, block := range sortedBlocks[gotado.DayTypeFriday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.Fri[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.Fri[i])
 		}
 		for i, block := range sortedBlocks[gotado.DayTypeSaturday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.Sat[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.Sat[i])
 		}
 		for i, block := range sortedBlocks[gotado.DayTypeSunday] {
-			timeBlockObjectToTimeBlockModel(ctx, block, &data.Sun[i])
+			timeBlockObjectToTimeBlockModel(ctx, block, unit, &data.Sun[i])
 		}
 	}
 }
 
-func heatingScheduleResourceModelToObject(ctx context.Context, data HeatingScheduleResourceModel, zone *gotado.Zone) (*gotado.HeatingSchedule, diag.Diagnostics) {
+// keepEquivalentTimeBlocks keeps the time blocks of the prior state or plan if
+// they result in the schedule read from tado, e.g. if tado rounded their
+// temperatures to the step of the temperature unit, so that they don't show up
+// as a difference.
+func keepEquivalentTimeBlocks(expected HeatingScheduleResourceModel, data *HeatingScheduleResourceModel) {
+	if !expected.TemperatureUnit.Equal(data.TemperatureUnit) {
+		return
+	}
+	unit := gotado.TemperatureUnit(data.TemperatureUnit.ValueString())
+	switch {
+	case data.Days != nil:
+		if expected.Days != nil && equalDaysModels(*expected.Days, *data.Days, unit) {
+			data.Days = expected.Days
+		}
+	case data.Timetables == nil:
+		if equalHeatingScheduleModels(expected, *data, unit) {
+			data.MonSun, data.MonFri = expected.MonSun, expected.MonFri
+			data.Mon, data.Tue, data.Wed, data.Thu, data.Fri, data.Sat, data.Sun = expected.Mon, expected.Tue, expected.Wed, expected.Thu, expected.Fri, expected.Sat, expected.Sun
+		}
+	}
+}
+
+// dayTimeBlocks holds the time blocks of a single day type.
+type dayTimeBlocks struct {
+	dayType gotado.DayType
+	blocks  []TimeBlockModel
+}
+
+func heatingScheduleResourceModelToObject(ctx context.Context, data HeatingScheduleResourceModel, home *gotado.Home, zone *gotado.Zone) (*gotado.HeatingSchedule, diag.Diagnostics) {
 	var err error
 	var schedule *gotado.HeatingSchedule
+	var days []dayTimeBlocks
 	diags := diag.Diagnostics{}
+	if data.Days != nil {
+		data = compactHeatingScheduleDays(*data.Days, temperatureUnit(data.TemperatureUnit, home))
+	}
 	switch {
 	case isMonSunSchedule(data):
 		schedule, err = zone.ScheduleMonToSun(ctx)
-		if err != nil {
-			diags.AddError("Tado API Error", fmt.Sprintf("Unable to initialize schedule for zone '%s': %v", zone.Name, err))
-			return nil, diags
-		}
-		first := data.MonSun[0]
-		power := boolToPower(first.Heating.ValueBool())
-		schedule.NewTimeBlock(ctx, gotado.DayTypeMondayToSunday,
-			first.Start.ValueString(),
-			first.End.ValueString(),
-			first.GeofencingControl.ValueBool(),
-			power,
-			first.Temperature.ValueFloat64())
-		for _, block := range data.MonSun[1:] {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeMondayToSunday,
-				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
+		days = []dayTimeBlocks{
+			{gotado.DayTypeMondayToSunday, data.MonSun},
 		}
 	case isMonFriSatSunSchedule(data):
 		schedule, err = zone.ScheduleMonToFriSatSun(ctx)
-		if err != nil {
-			diags.AddError("Tado API Error", fmt.Sprintf("Unable to initialize schedule for zone '%s': %v", zone.Name, err))
-			return nil, diags
-		}
-		first := data.MonFri[0]
-		power := boolToPower(first.Heating.ValueBool())
-		schedule.NewTimeBlock(ctx, gotado.DayTypeMondayToFriday,
-			first.Start.ValueString(),
-			first.End.ValueString(),
-			first.GeofencingControl.ValueBool(),
-			power,
-			first.Temperature.ValueFloat64())
-		for _, block := range data.MonFri[1:] {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeMondayToFriday,
-				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
-		}
-		for _, block := range data.Sat {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeSaturday,
-				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
-		}
-		for _, block := range data.Sun {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeSunday,
-				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
+		days = []dayTimeBlocks{
+			{gotado.DayTypeMondayToFriday, data.MonFri},
+			{gotado.DayTypeSaturday, data.Sat},
+			{gotado.DayTypeSunday, data.Sun},
 		}
 	case isMonTueWedThuFriSatSunSchedule(data):
 		schedule, err = zone.ScheduleAllDays(ctx)
-		if err != nil {
-			diags.AddError("Tado API Error", fmt.Sprintf("Unable to initialize schedule for zone '%s': %v", zone.Name, err))
-			return nil, diags
+		days = []dayTimeBlocks{
+			{gotado.DayTypeMonday, data.Mon},
+			{gotado.DayTypeTuesday, data.Tue},
+			{gotado.DayTypeWednesday, data.Wed},
+			{gotado.DayTypeThursday, data.Thu},
+			{gotado.DayTypeFriday, data.Fri},
+			{gotado.DayTypeSaturday, data.Sat},
+			{gotado.DayTypeSunday, data.Sun},
 		}
-		first := data.Mon[0]
-		power := boolToPower(first.Heating.ValueBool())
-		schedule.NewTimeBlock(ctx, gotado.DayTypeMonday,
-			first.Start.ValueString(),
-			first.End.ValueString(),
-			first.GeofencingControl.ValueBool(),
-			power,
-			first.Temperature.ValueFloat64())
-		for _, block := range data.Mon[1:] {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeMonday,
-				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
-		}
-		for _, block := range data.Tue {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeTuesday,
-				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
-		}
-		for _, block := range data.Wed {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeWednesday,
-				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
-		}
-		for _, block := range data.Thu {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeThursday,
-				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
-		}
-		for _, block := range data.Fri {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeFriday,
-				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
-		}
-		for _, block := range data.Sat {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeSaturday,
-				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
-		}
-		for _, block := range data.Sun {
-			power := boolToPower(block.Heating.ValueBool())
-			schedule.AddTimeBlock(ctx, gotado.DayTypeSunday,
+	default:
+		diags.AddError("Invalid Heating Schedule", fmt.Sprintf("Unable to create heating schedule for zone '%s': No valid schedule provided", zone.Name))
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddError("Tado API Error", fmt.Sprintf("Unable to initialize schedule for zone '%s': %v", zone.Name, err))
+		return nil, diags
+	}
+
+	// Replace the current time blocks of the timetable with the given ones.
+	// Temperatures are set in the unit of the home.
+	unit := temperatureUnit(data.TemperatureUnit, home)
+	schedule.Blocks = nil
+	for _, day := range days {
+		for _, block := range day.blocks {
+			schedule.AddTimeBlock(ctx, day.dayType,
 				block.Start.ValueString(),
-				block.End.ValueString(),
-				block.GeofencingControl.ValueBool(),
-				power,
-				block.Temperature.ValueFloat64())
+				normalizeEndOfBlock(block.End.ValueString()),
+				!geofencingControl(block),
+				boolToPower(block.Heating.ValueBool()),
+				convertTemperature(block.Temperature.ValueFloat64(), unit, home.TemperatureUnit))
+		}
+	}
+	return schedule, diags
+}
+
+// geofencingControl returns whether geofencing controls a time block, which
+// is the case unless it is explicitly turned off.
+func geofencingControl(block TimeBlockModel) bool {
+	if block.GeofencingControl.IsNull() || block.GeofencingControl.IsUnknown() {
+		return true
+	}
+	return block.GeofencingControl.ValueBool()
+}
+
+// heatingScheduleToDaysModel expands a heating schedule of any timetable to the
+// time blocks of each day of the week, with temperatures in the given unit.
+func heatingScheduleToDaysModel(ctx context.Context, schedule *gotado.HeatingSchedule, unit gotado.TemperatureUnit) *HeatingScheduleDaysModel {
+	sortedBlocks := sortTimeBlocksByDayType(schedule.Blocks)
+
+	dayModels := func(dayType gotado.DayType) []TimeBlockModel {
+		switch schedule.ScheduleDays {
+		case gotado.ScheduleDaysMonToSun:
+			dayType = gotado.DayTypeMondayToSunday
+		case gotado.ScheduleDaysMonToFriSatSun:
+			if dayType != gotado.DayTypeSaturday && dayType != gotado.DayTypeSunday {
+				dayType = gotado.DayTypeMondayToFriday
+			}
 		}
+		return timeBlockObjectsToTimeBlockModels(ctx, sortedBlocks[dayType], unit)
+	}
+
+	return &HeatingScheduleDaysModel{
+		Mon: dayModels(gotado.DayTypeMonday),
+		Tue: dayModels(gotado.DayTypeTuesday),
+		Wed: dayModels(gotado.DayTypeWednesday),
+		Thu: dayModels(gotado.DayTypeThursday),
+		Fri: dayModels(gotado.DayTypeFriday),
+		Sat: dayModels(gotado.DayTypeSaturday),
+		Sun: dayModels(gotado.DayTypeSunday),
+	}
+}
+
+// getHeatingScheduleTimetables reads the time blocks of all timetables which
+// are managed in the given timetables model, with temperatures in the given
+// unit.
+func getHeatingScheduleTimetables(ctx context.Context, zone *gotado.Zone, timetables *HeatingScheduleTimetablesModel, unit gotado.TemperatureUnit) (*HeatingScheduleTimetablesModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	result := &HeatingScheduleTimetablesModel{}
+
+	if timetables.MonSun != nil {
+		schedule, err := zone.ScheduleMonToSun(ctx)
+		if err != nil {
+			diags.AddError("Tado API Error", fmt.Sprintf("Unable to get %s timetable of zone '%s': %v", timetableMonSun, zone.Name, err))
+			return timetables, diags
+		}
+		sortedBlocks := sortTimeBlocksByDayType(schedule.Blocks)
+		result.MonSun = timeBlockObjectsToTimeBlockModels(ctx, sortedBlocks[gotado.DayTypeMondayToSunday], unit)
+	}
+
+	if timetables.MonFriSatSun != nil {
+		schedule, err := zone.ScheduleMonToFriSatSun(ctx)
+		if err != nil {
+			diags.AddError("Tado API Error", fmt.Sprintf("Unable to get %s timetable of zone '%s': %v", timetableMonFriSatSun, zone.Name, err))
+			return timetables, diags
+		}
+		sortedBlocks := sortTimeBlocksByDayType(schedule.Blocks)
+		result.MonFriSatSun = &HeatingScheduleMonFriSatSunModel{
+			MonFri: timeBlockObjectsToTimeBlockModels(ctx, sortedBlocks[gotado.DayTypeMondayToFriday], unit),
+			Sat:    timeBlockObjectsToTimeBlockModels(ctx, sortedBlocks[gotado.DayTypeSaturday], unit),
+			Sun:    timeBlockObjectsToTimeBlockModels(ctx, sortedBlocks[gotado.DayTypeSunday], unit),
+		}
+	}
+
+	if timetables.AllDays != nil {
+		schedule, err := zone.ScheduleAllDays(ctx)
+		if err != nil {
+			diags.AddError("Tado API Error", fmt.Sprintf("Unable to get %s timetable of zone '%s': %v", timetableAllDays, zone.Name, err))
+			return timetables, diags
+		}
+		schedule.ScheduleDays = gotado.ScheduleDaysMonTueWedThuFriSatSun
+		result.AllDays = heatingScheduleToDaysModel(ctx, schedule, unit)
+	}
+
+	return result, diags
+}
+
+// setHeatingScheduleTimetables writes the time blocks of all managed
+// timetables which differ from the prior state and then activates the
+// configured timetable. Prior is nil if the resource is created.
+func setHeatingScheduleTimetables(ctx context.Context, home *gotado.Home, zone *gotado.Zone, data HeatingScheduleResourceModel, prior *HeatingScheduleResourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	// Timetables are only unchanged if their temperatures are in the same unit.
+	priorTimetables := map[string]HeatingScheduleResourceModel{}
+	if prior != nil && prior.Timetables != nil && prior.TemperatureUnit.Equal(data.TemperatureUnit) {
+		priorTimetables = timetablesToResourceModels(*prior.Timetables, prior.TemperatureUnit)
+	}
+
+	timetables := timetablesToResourceModels(*data.Timetables, data.TemperatureUnit)
+	for _, name := range []string{timetableMonSun, timetableMonFriSatSun, timetableAllDays} {
+		timetable, ok := timetables[name]
+		if !ok {
+			continue
+		}
+		if priorTimetable, ok := priorTimetables[name]; ok && equalHeatingScheduleModels(timetable, priorTimetable, gotado.TemperatureUnit(data.TemperatureUnit.ValueString())) {
+			continue
+		}
+
+		schedule, scheduleDiags := heatingScheduleResourceModelToObject(ctx, timetable, home, zone)
+		diags.Append(scheduleDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if err := schedule.Timetable.SetTimeBlocks(ctx, schedule.Blocks); err != nil {
+			diags.AddError("Tado API Error", fmt.Sprintf("Unable to set %s timetable of zone '%s': %v", name, zone.Name, err))
+			return diags
+		}
+	}
+
+	active := data.ActiveTimetable
+	if active.IsNull() || active.IsUnknown() || (prior != nil && prior.ActiveTimetable.Equal(active)) {
+		return diags
+	}
+	if err := zone.SetActiveScheduleTimetable(ctx, &gotado.ScheduleTimetable{ID: timetableIDs[active.ValueString()]}); err != nil {
+		diags.AddError("Tado API Error", fmt.Sprintf("Unable to activate %s timetable of zone '%s': %v", active.ValueString(), zone.Name, err))
+	}
+	return diags
+}
+
+// waitForHeatingSchedule reads the heating schedule of a zone until it
+// reflects the given schedule, which has just been written. If the time
+// blocks were written per timetable, written is nil and only the active
+// timetable is compared.
+func waitForHeatingSchedule(ctx context.Context, c convergence, home *gotado.Home, zone *gotado.Zone, written *gotado.HeatingSchedule, active types.String) (*gotado.HeatingSchedule, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	schedule, converged, err := waitForConvergence(ctx, c, zone.GetHeatingSchedule, func(schedule *gotado.HeatingSchedule) bool {
+		if written == nil {
+			return active.IsNull() || active.IsUnknown() || scheduleDaysToTimetable(schedule.ScheduleDays) == active.ValueString()
+		}
+		// The summaries include the day types, and with them the timetable.
+		return summarizeHeatingSchedule(schedule.Blocks, home.TemperatureUnit) == summarizeHeatingSchedule(written.Blocks, home.TemperatureUnit)
+	})
+	if err != nil {
+		diags.AddError("Tado API Error", fmt.Sprintf("Unable to get heating schedule for zone '%s': %v", zone.Name, err))
+		return nil, diags
+	}
+	if !converged {
+		diags.AddError(
+			"Tado API Error",
+			fmt.Sprintf("Heating schedule of zone '%s' still differs from the written schedule %s after the change. Increase 'convergence_timeout' of the provider if tado takes longer to apply changes.", zone.Name, c.timeout),
+		)
+		return nil, diags
+	}
+
+	return schedule, diags
+}
+
+// timetablesToResourceModels returns a resource model for each managed
+// timetable, keyed by the name of the timetable. The temperatures of all
+// timetables are in the given unit.
+func timetablesToResourceModels(timetables HeatingScheduleTimetablesModel, unit types.String) map[string]HeatingScheduleResourceModel {
+	models := make(map[string]HeatingScheduleResourceModel)
+	if timetables.MonSun != nil {
+		models[timetableMonSun] = HeatingScheduleResourceModel{MonSun: timetables.MonSun, TemperatureUnit: unit}
+	}
+	if timetables.MonFriSatSun != nil {
+		models[timetableMonFriSatSun] = HeatingScheduleResourceModel{
+			MonFri:          timetables.MonFriSatSun.MonFri,
+			Sat:             timetables.MonFriSatSun.Sat,
+			Sun:             timetables.MonFriSatSun.Sun,
+			TemperatureUnit: unit,
+		}
+	}
+	if timetables.AllDays != nil {
+		days := timetables.AllDays
+		models[timetableAllDays] = HeatingScheduleResourceModel{
+			Mon:             days.Mon,
+			Tue:             days.Tue,
+			Wed:             days.Wed,
+			Thu:             days.Thu,
+			Fri:             days.Fri,
+			Sat:             days.Sat,
+			Sun:             days.Sun,
+			TemperatureUnit: unit,
+		}
+	}
+	return models
+}
+
+// equalHeatingScheduleModels checks if the day attributes of two heating
+// schedules with temperatures in the given unit result in the same schedule.
+func equalHeatingScheduleModels(a, b HeatingScheduleResourceModel, unit gotado.TemperatureUnit) bool {
+	pairs := [][2][]TimeBlockModel{
+		{a.MonSun, b.MonSun}, {a.MonFri, b.MonFri},
+		{a.Mon, b.Mon}, {a.Tue, b.Tue}, {a.Wed, b.Wed}, {a.Thu, b.Thu}, {a.Fri, b.Fri}, {a.Sat, b.Sat}, {a.Sun, b.Sun},
+	}
+	for _, pair := range pairs {
+		if (pair[0] == nil) != (pair[1] == nil) || !equalTimeBlockModels(pair[0], pair[1], unit) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalDaysModels checks if two schedules described day by day are equal.
+func equalDaysModels(a, b HeatingScheduleDaysModel, unit gotado.TemperatureUnit) bool {
+	return equalHeatingScheduleModels(
+		HeatingScheduleResourceModel{Mon: a.Mon, Tue: a.Tue, Wed: a.Wed, Thu: a.Thu, Fri: a.Fri, Sat: a.Sat, Sun: a.Sun},
+		HeatingScheduleResourceModel{Mon: b.Mon, Tue: b.Tue, Wed: b.Wed, Thu: b.Thu, Fri: b.Fri, Sat: b.Sat, Sun: b.Sun},
+		unit,
+	)
+}
+
+// isCompleteDaysModel checks if the time blocks of all days are known.
+func isCompleteDaysModel(days HeatingScheduleDaysModel) bool {
+	for _, blocks := range [][]TimeBlockModel{days.Mon, days.Tue, days.Wed, days.Thu, days.Fri, days.Sat, days.Sun} {
+		if _, ok := normalizeTimeBlockModels(blocks, gotado.TemperatureUnitCelsius); blocks == nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// compactHeatingScheduleDays returns a resource model with the most compact
+// timetable which is equivalent to the given days: Monday - Sunday if all days
+// are equal, Monday - Friday, Saturday, Sunday if all weekdays are equal, or
+// all seven days otherwise. Temperatures are compared in the given unit.
+func compactHeatingScheduleDays(days HeatingScheduleDaysModel, unit gotado.TemperatureUnit) HeatingScheduleResourceModel {
+	weekdays := [][]TimeBlockModel{days.Tue, days.Wed, days.Thu, days.Fri}
+	for _, weekday := range weekdays {
+		if !equalTimeBlockModels(days.Mon, weekday, unit) {
+			return HeatingScheduleResourceModel{
+				Mon: days.Mon,
+				Tue: days.Tue,
+				Wed: days.Wed,
+				Thu: days.Thu,
+				Fri: days.Fri,
+				Sat: days.Sat,
+				Sun: days.Sun,
+			}
+		}
+	}
+	if equalTimeBlockModels(days.Mon, days.Sat, unit) && equalTimeBlockModels(days.Mon, days.Sun, unit) {
+		return HeatingScheduleResourceModel{MonSun: days.Mon}
+	}
+	return HeatingScheduleResourceModel{MonFri: days.Mon, Sat: days.Sat, Sun: days.Sun}
+}
+
+// equalTimeBlockModels checks if two lists of time blocks with temperatures in
+// the given unit result in the same schedule in tado. Lists with unknown or
+// invalid blocks are never equal.
+func equalTimeBlockModels(a, b []TimeBlockModel, unit gotado.TemperatureUnit) bool {
+	normalizedA, ok := normalizeTimeBlockModels(a, unit)
+	if !ok {
+		return false
+	}
+	normalizedB, ok := normalizeTimeBlockModels(b, unit)
+	if !ok {
+		return false
+	}
+	return slices.Equal(normalizedA, normalizedB)
+}
+
+// heatingScheduleSnapshotToObject converts a heating schedule stored in
+// private state back into a heating schedule of the given zone.
+func heatingScheduleSnapshotToObject(ctx context.Context, snapshot []byte, zone *gotado.Zone) (*gotado.HeatingSchedule, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	var original heatingScheduleSnapshot
+	if err := json.Unmarshal(snapshot, &original); err != nil {
+		diags.AddError("Unable to restore heating schedule", fmt.Sprintf("Unable to decode original heating schedule of zone '%s': %v", zone.Name, err))
+		return nil, diags
+	}
+
+	var err error
+	var schedule *gotado.HeatingSchedule
+	switch original.ScheduleDays {
+	case gotado.ScheduleDaysMonToSun:
+		schedule, err = zone.ScheduleMonToSun(ctx)
+	case gotado.ScheduleDaysMonToFriSatSun:
+		schedule, err = zone.ScheduleMonToFriSatSun(ctx)
+	case gotado.ScheduleDaysMonTueWedThuFriSatSun:
+		schedule, err = zone.ScheduleAllDays(ctx)
 	default:
-		diags.AddError("Invalid Heating Schedule", fmt.Sprintf("Unable to create heating schedule for zone '%s': No valid schedule provided", zone.Name))
+		diags.AddError("Unable to restore heating schedule", fmt.Sprintf("Unknown timetable '%s' in original heating schedule of zone '%s'", original.ScheduleDays, zone.Name))
 		return nil, diags
 	}
-	return schedule, nil
+	if err != nil {
+		diags.AddError("Tado API Error", fmt.Sprintf("Unable to initialize schedule for zone '%s': %v", zone.Name, err))
+		return nil, diags
+	}
+
+	schedule.Blocks = original.Blocks
+	return schedule, diags
 }
 
 func sortTimeBlocksByDayType(blocks []*gotado.ScheduleTimeBlock) map[gotado.DayType][]*gotado.ScheduleTimeBlock {
@@ -577,10 +1794,20 @@ func sortTimeBlocksByDayType(blocks []*gotado.ScheduleTimeBlock) map[gotado.DayT
 	return sortedBlocks
 }
 
-func timeBlockObjectToTimeBlockModel(_ context.Context, block *gotado.ScheduleTimeBlock, model *TimeBlockModel) {
+// timeBlockObjectsToTimeBlockModels converts a list of time blocks of a single
+// day type into time block models, with temperatures in the given unit.
+func timeBlockObjectsToTimeBlockModels(ctx context.Context, blocks []*gotado.ScheduleTimeBlock, unit gotado.TemperatureUnit) []TimeBlockModel {
+	models := make([]TimeBlockModel, len(blocks))
+	for i, block := range blocks {
+		timeBlockObjectToTimeBlockModel(ctx, block, unit, &models[i])
+	}
+	return models
+}
+
+func timeBlockObjectToTimeBlockModel(_ context.Context, block *gotado.ScheduleTimeBlock, unit gotado.TemperatureUnit, model *TimeBlockModel) {
 	model.Heating = types.BoolValue(block.Setting.Power == "ON")
 	if block.Setting.Temperature != nil {
-		model.Temperature = types.Float64Value(block.Setting.Temperature.Celsius)
+		model.Temperature = types.Float64Value(settingTemperature(block.Setting.Temperature, unit))
 	}
 	model.Start = types.StringValue(block.Start)
 	model.End = types.StringValue(block.End)
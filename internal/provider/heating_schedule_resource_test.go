@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -224,3 +228,246 @@ func TestIsMonTueWedThuFriSatSunSchedule(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckTimeBlockTemperatures(t *testing.T) {
+	values := &gotado.ZoneCapabilitiesTemperatureValues{Min: 5, Max: 25, Step: 0.1}
+	blocks := map[string][]TimeBlockModel{
+		"mon_fri": {
+			{Heating: types.BoolValue(false), Temperature: types.Float64Null()},
+			{Heating: types.BoolValue(true), Temperature: types.Float64Value(20)},
+			{Heating: types.BoolValue(true), Temperature: types.Float64Value(4.5)},
+		},
+		"sat": {
+			{Heating: types.BoolValue(true), Temperature: types.Float64Unknown()},
+			{Heating: types.BoolValue(true), Temperature: types.Float64Value(30)},
+		},
+	}
+
+	diags := checkTimeBlockTemperatures(blocks, "Living Room", values)
+
+	expected := []path.Path{
+		path.Root("mon_fri").AtListIndex(2).AtName("temperature"),
+		path.Root("sat").AtListIndex(1).AtName("temperature"),
+	}
+	if len(diags) != len(expected) {
+		t.Fatalf("Expected %d diagnostics, got: %v", len(expected), diags)
+	}
+	for i, d := range diags {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(expected[i]) {
+			t.Errorf("Expected diagnostic for %s, got: %v", expected[i], d)
+		}
+	}
+}
+
+func TestHeatingScheduleSnapshotToObject(t *testing.T) {
+	zone := &gotado.Zone{Name: "Living Room"}
+
+	if _, diags := heatingScheduleSnapshotToObject(context.Background(), []byte(`{`), zone); !diags.HasError() {
+		t.Error("Expected error for invalid snapshot, got none")
+	}
+
+	if _, diags := heatingScheduleSnapshotToObject(context.Background(), []byte(`{"schedule_days":"UNKNOWN","blocks":[]}`), zone); !diags.HasError() {
+		t.Error("Expected error for unknown timetable, got none")
+	}
+}
+
+func TestCompactHeatingScheduleDays(t *testing.T) {
+	block := func(heating bool, temperature float64) []TimeBlockModel {
+		model := TimeBlockModel{
+			Heating:           types.BoolValue(heating),
+			Temperature:       types.Float64Null(),
+			Start:             types.StringValue("00:00"),
+			End:               types.StringValue("00:00"),
+			GeofencingControl: types.BoolNull(),
+		}
+		if heating {
+			model.Temperature = types.Float64Value(temperature)
+		}
+		return []TimeBlockModel{model}
+	}
+	warm, cold, off := block(true, 21), block(true, 18), block(false, 0)
+	offWithTemperature := block(false, 0)
+	offWithTemperature[0].Temperature = types.Float64Value(5)
+
+	cases := map[string]struct {
+		days     HeatingScheduleDaysModel
+		expected string
+	}{
+		"all days equal": {
+			days:     HeatingScheduleDaysModel{Mon: warm, Tue: warm, Wed: warm, Thu: warm, Fri: warm, Sat: warm, Sun: warm},
+			expected: timetableMonSun,
+		},
+		"temperature of blocks turning heating off is ignored": {
+			days:     HeatingScheduleDaysModel{Mon: off, Tue: off, Wed: off, Thu: offWithTemperature, Fri: off, Sat: off, Sun: off},
+			expected: timetableMonSun,
+		},
+		"weekdays equal": {
+			days:     HeatingScheduleDaysModel{Mon: warm, Tue: warm, Wed: warm, Thu: warm, Fri: warm, Sat: cold, Sun: warm},
+			expected: timetableMonFriSatSun,
+		},
+		"weekdays differ": {
+			days:     HeatingScheduleDaysModel{Mon: warm, Tue: warm, Wed: cold, Thu: warm, Fri: warm, Sat: warm, Sun: warm},
+			expected: timetableAllDays,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data := compactHeatingScheduleDays(tc.days, gotado.TemperatureUnitCelsius)
+			var timetable string
+			switch {
+			case isMonSunSchedule(data):
+				timetable = timetableMonSun
+			case isMonFriSatSunSchedule(data):
+				timetable = timetableMonFriSatSun
+			case isMonTueWedThuFriSatSunSchedule(data):
+				timetable = timetableAllDays
+			}
+			if timetable != tc.expected {
+				t.Fatalf("Expected: %s, got: %s", tc.expected, timetable)
+			}
+		})
+	}
+}
+
+func TestKeepEquivalentTimeBlocks(t *testing.T) {
+	block := func(temperature float64) []TimeBlockModel {
+		return []TimeBlockModel{{
+			Heating:           types.BoolValue(true),
+			Temperature:       types.Float64Value(temperature),
+			Start:             types.StringValue("00:00"),
+			End:               types.StringValue("00:00"),
+			GeofencingControl: types.BoolNull(),
+		}}
+	}
+
+	cases := map[string]struct {
+		unit     gotado.TemperatureUnit
+		expected float64
+		read     float64
+		kept     bool
+	}{
+		"fahrenheit rounded to whole degrees": {
+			unit:     gotado.TemperatureUnitFahrenheit,
+			expected: 68.4,
+			read:     68,
+			kept:     true,
+		},
+		"fahrenheit changed": {
+			unit:     gotado.TemperatureUnitFahrenheit,
+			expected: 68,
+			read:     69,
+			kept:     false,
+		},
+		"celsius rounded to tenths": {
+			unit:     gotado.TemperatureUnitCelsius,
+			expected: 20.04,
+			read:     20,
+			kept:     true,
+		},
+		"celsius changed by a step": {
+			unit:     gotado.TemperatureUnitCelsius,
+			expected: 20.4,
+			read:     20,
+			kept:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			unit := types.StringValue(string(tc.unit))
+			expected := HeatingScheduleResourceModel{MonSun: block(tc.expected), TemperatureUnit: unit}
+			data := HeatingScheduleResourceModel{MonSun: block(tc.read), TemperatureUnit: unit}
+			keepEquivalentTimeBlocks(expected, &data)
+			if kept := data.MonSun[0].Temperature.ValueFloat64() == tc.expected; kept != tc.kept {
+				t.Fatalf("Expected: kept %v, got: %v (temperature %v)", tc.kept, kept, data.MonSun[0].Temperature)
+			}
+		})
+	}
+}
+
+func TestHeatingScheduleToDaysModel(t *testing.T) {
+	block := func(dayType gotado.DayType, celsius float64) *gotado.ScheduleTimeBlock {
+		return &gotado.ScheduleTimeBlock{
+			DayType: dayType,
+			Start:   "00:00",
+			End:     "00:00",
+			Setting: &gotado.ZoneSetting{Power: gotado.PowerOn, Temperature: &gotado.ZoneSettingTemperature{Celsius: celsius}},
+		}
+	}
+
+	schedule := &gotado.HeatingSchedule{
+		ScheduleDays: gotado.ScheduleDaysMonToFriSatSun,
+		Blocks: []*gotado.ScheduleTimeBlock{
+			block(gotado.DayTypeMondayToFriday, 20),
+			block(gotado.DayTypeSaturday, 21),
+			block(gotado.DayTypeSunday, 22),
+		},
+	}
+
+	days := heatingScheduleToDaysModel(context.Background(), schedule, gotado.TemperatureUnitCelsius)
+	expected := map[string]struct {
+		blocks      []TimeBlockModel
+		temperature float64
+	}{
+		"mon": {days.Mon, 20},
+		"tue": {days.Tue, 20},
+		"wed": {days.Wed, 20},
+		"thu": {days.Thu, 20},
+		"fri": {days.Fri, 20},
+		"sat": {days.Sat, 21},
+		"sun": {days.Sun, 22},
+	}
+	for day, e := range expected {
+		if len(e.blocks) != 1 {
+			t.Fatalf("Expected: one block for %s, got: %d", day, len(e.blocks))
+		}
+		if e.blocks[0].Temperature.ValueFloat64() != e.temperature {
+			t.Errorf("Expected: temperature %v for %s, got: %v", e.temperature, day, e.blocks[0].Temperature)
+		}
+	}
+}
+
+func TestTimeBlockListPath(t *testing.T) {
+	cases := map[string]path.Path{
+		"mon_sun":                            path.Root("mon_sun"),
+		"days.mon":                           path.Root("days").AtName("mon"),
+		"timetables.mon_fri_sat_sun.mon_fri": path.Root("timetables").AtName("mon_fri_sat_sun").AtName("mon_fri"),
+	}
+
+	for name, expected := range cases {
+		t.Run(name, func(t *testing.T) {
+			if p := timeBlockListPath(name); !p.Equal(expected) {
+				t.Fatalf("Expected: %s, got: %s", expected, p)
+			}
+		})
+	}
+}
+
+func TestTimetablesToResourceModels(t *testing.T) {
+	blocks := []TimeBlockModel{{Heating: types.BoolValue(false), Start: types.StringValue("00:00"), End: types.StringValue("00:00")}}
+	timetables := timeBlockModelsToResourceModel(map[string][]TimeBlockModel{
+		"timetables.mon_sun":                 blocks,
+		"timetables.mon_fri_sat_sun.mon_fri": blocks,
+		"timetables.mon_fri_sat_sun.sat":     blocks,
+		"timetables.mon_fri_sat_sun.sun":     blocks,
+	}).Timetables
+	if timetables == nil {
+		t.Fatalf("Expected: timetables, got: nil")
+	}
+
+	models := timetablesToResourceModels(*timetables, types.StringValue(string(gotado.TemperatureUnitCelsius)))
+	if len(models) != 2 {
+		t.Fatalf("Expected: 2 timetables, got: %d", len(models))
+	}
+	if !isMonSunSchedule(models[timetableMonSun]) {
+		t.Errorf("Expected: valid %s timetable, got: %v", timetableMonSun, models[timetableMonSun])
+	}
+	if !isMonFriSatSunSchedule(models[timetableMonFriSatSun]) {
+		t.Errorf("Expected: valid %s timetable, got: %v", timetableMonFriSatSun, models[timetableMonFriSatSun])
+	}
+	if _, ok := models[timetableAllDays]; ok {
+		t.Errorf("Expected: no %s timetable, got: %v", timetableAllDays, models[timetableAllDays])
+	}
+}
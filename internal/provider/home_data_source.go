@@ -5,8 +5,12 @@ import (
 	"fmt"
 
 	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,38 +26,44 @@ type HomeDataSource struct {
 }
 
 type HomeDataSourceModel struct {
-	ID              types.Int64   `tfsdk:"id"`
-	Name            types.String  `tfsdk:"name"`
-	TemperatureUnit types.String  `tfsdk:"temperature_unit"`
-	ContactName     types.String  `tfsdk:"contact_name"`
-	ContactEmail    types.String  `tfsdk:"contact_email"`
-	ContactPhone    types.String  `tfsdk:"contact_phone"`
-	AddressLine1    types.String  `tfsdk:"address_line1"`
-	AddressLine2    types.String  `tfsdk:"address_line2"`
-	AddressZipcode  types.String  `tfsdk:"address_zipcode"`
-	AddressCity     types.String  `tfsdk:"address_city"`
-	AddressState    types.String  `tfsdk:"address_state"`
-	AddressCountry  types.String  `tfsdk:"address_country"`
-	GeolocationLat  types.Float64 `tfsdk:"geolocation_lat"`
-	GeolocationLong types.Float64 `tfsdk:"geolocation_long"`
+	ID              types.Int64    `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	TemperatureUnit types.String   `tfsdk:"temperature_unit"`
+	ContactName     types.String   `tfsdk:"contact_name"`
+	ContactEmail    types.String   `tfsdk:"contact_email"`
+	ContactPhone    types.String   `tfsdk:"contact_phone"`
+	AddressLine1    types.String   `tfsdk:"address_line1"`
+	AddressLine2    types.String   `tfsdk:"address_line2"`
+	AddressZipcode  types.String   `tfsdk:"address_zipcode"`
+	AddressCity     types.String   `tfsdk:"address_city"`
+	AddressState    types.String   `tfsdk:"address_state"`
+	AddressCountry  types.String   `tfsdk:"address_country"`
+	GeolocationLat  types.Float64  `tfsdk:"geolocation_lat"`
+	GeolocationLong types.Float64  `tfsdk:"geolocation_long"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (*HomeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_home"
 }
 
-func (HomeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+func (HomeDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "A tado home holds all tado devices and heating zones. The home data source provides information such as contact details, address, etc.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "Home ID.",
+				MarkdownDescription: "Home ID. Either `id` or `name` must be set.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the home.",
-				Required:            true,
+				MarkdownDescription: "Name of the home. Either `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("id")),
+				},
 			},
 			"temperature_unit": schema.StringAttribute{
 				MarkdownDescription: "Temperature unit used in the home. Either 'Celsius' or 'Fahrenheit'.",
@@ -104,6 +114,10 @@ func (HomeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp
 				Computed:            true,
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
 	}
 }
 
@@ -124,7 +138,7 @@ func (d *HomeDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	d.client = gotado.NewWithTokenRefreshCallback(ctx, data.config, data.token, createTokenUpdateCallback(data.tokenPath, &resp.Diagnostics))
+	d.client = data.newClient(ctx, &resp.Diagnostics)
 }
 
 func (d HomeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -137,15 +151,32 @@ func (d HomeDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	me, err := d.client.Me(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Tado Authentication Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
 		return
 	}
 
-	home, err := me.GetHome(ctx, data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Tado Authentication Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+	home, diags := getHome(ctx, me, data.ID, data.Name)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkName(path.Root("name"), "Home", data.Name, home.Name)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
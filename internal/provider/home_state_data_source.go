@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &HomeStateDataSource{}
+
+func NewHomeStateDataSource() datasource.DataSource {
+	return &HomeStateDataSource{}
+}
+
+type HomeStateDataSource struct {
+	client *gotado.Tado
+}
+
+type HomeStateDataSourceModel struct {
+	ID                  types.Int64    `tfsdk:"id"`
+	Home                types.String   `tfsdk:"home"`
+	Presence            types.String   `tfsdk:"presence"`
+	PresenceLocked      types.Bool     `tfsdk:"presence_locked"`
+	MobileDevicesAtHome []types.String `tfsdk:"mobile_devices_at_home"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (*HomeStateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_home_state"
+}
+
+func (HomeStateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The current state of a tado home, such as whether somebody is present and which mobile devices are at home.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Home ID. Either `id` or `home` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"home": schema.StringAttribute{
+				MarkdownDescription: "Name of the home. Either `id` or `home` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("id")),
+				},
+			},
+			"presence": schema.StringAttribute{
+				MarkdownDescription: "Whether somebody is present in the home. Either 'home' or 'away'.",
+				Computed:            true,
+			},
+			"presence_locked": schema.BoolAttribute{
+				MarkdownDescription: "Whether the presence is locked to its current value. If false, presence is determined automatically by geofencing.",
+				Computed:            true,
+			},
+			"mobile_devices_at_home": schema.ListAttribute{
+				MarkdownDescription: "Names of the mobile devices which are currently located at home.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *HomeStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*tadoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *tadoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.newClient(ctx, &resp.Diagnostics)
+}
+
+func (d HomeStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HomeStateDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	me, err := d.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, data.ID, data.Home)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkName(path.Root("home"), "Home", data.Home, home.Name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	homeState, err := home.GetState(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get state of home '%s': %v", home.Name, err))
+		return
+	}
+
+	mobileDevices, err := home.GetMobileDevices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get mobile devices of home '%s': %v", home.Name, err))
+		return
+	}
+
+	data.ID = types.Int64Value(int64(home.ID))
+	data.Home = types.StringValue(home.Name)
+	data.Presence = types.StringValue(strings.ToLower(string(homeState.Presence)))
+	data.PresenceLocked = types.BoolValue(homeState.PresenceLocked)
+	data.MobileDevicesAtHome = make([]types.String, 0)
+	for _, name := range mobileDevicesAtHome(mobileDevices) {
+		data.MobileDevicesAtHome = append(data.MobileDevicesAtHome, types.StringValue(name))
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// mobileDevicesAtHome returns the sorted names of all mobile devices which are
+// located at home. Devices without location information, e.g. because
+// geo tracking is disabled, are not considered to be at home.
+func mobileDevicesAtHome(mobileDevices []*gotado.MobileDevice) []string {
+	names := make([]string, 0, len(mobileDevices))
+	for _, mobileDevice := range mobileDevices {
+		if mobileDevice.Location != nil && mobileDevice.Location.AtHome {
+			names = append(names, mobileDevice.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
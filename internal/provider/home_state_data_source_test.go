@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gonzolino/gotado/v2"
+)
+
+func TestMobileDevicesAtHome(t *testing.T) {
+	cases := []struct {
+		mobileDevices []*gotado.MobileDevice
+		expected      []string
+	}{
+		// no mobile devices
+		{
+			mobileDevices: []*gotado.MobileDevice{},
+			expected:      []string{},
+		},
+		// devices at home are sorted by name
+		{
+			mobileDevices: []*gotado.MobileDevice{
+				{Name: "Phone B", Location: &gotado.MobileDeviceLocation{AtHome: true}},
+				{Name: "Phone A", Location: &gotado.MobileDeviceLocation{AtHome: true}},
+			},
+			expected: []string{"Phone A", "Phone B"},
+		},
+		// devices away or without location are ignored
+		{
+			mobileDevices: []*gotado.MobileDevice{
+				{Name: "Phone A", Location: &gotado.MobileDeviceLocation{AtHome: false}},
+				{Name: "Phone B", Location: nil},
+				{Name: "Phone C", Location: &gotado.MobileDeviceLocation{AtHome: true}},
+			},
+			expected: []string{"Phone C"},
+		},
+	}
+
+	for _, c := range cases {
+		actual := mobileDevicesAtHome(c.mobileDevices)
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("Expected: %v, got: %v", c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// minutesPerWeek is the number of minutes in a week.
+const minutesPerWeek = 7 * minutesPerDay
+
+// icalEvent is a weekly recurring event of an iCalendar file.
+type icalEvent struct {
+	summary string
+	// starts are the minutes since the start of the week (Monday 00:00) at
+	// which the event starts each week.
+	starts []int
+	// duration is the duration of the event in minutes.
+	duration int
+}
+
+// icalProperty is a content line of an iCalendar file, e.g.
+// 'DTSTART;TZID=Europe/Berlin:20240101T080000'.
+type icalProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICalProperties unfolds the content lines of an iCalendar file and
+// splits them into properties.
+func parseICalProperties(content string) []icalProperty {
+	lines := []string{}
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if n := len(lines); n > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[n-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	properties := make([]icalProperty, 0, len(lines))
+	for _, line := range lines {
+		nameAndParams, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		parts := strings.Split(nameAndParams, ";")
+		property := icalProperty{name: strings.ToUpper(parts[0]), params: map[string]string{}, value: value}
+		for _, param := range parts[1:] {
+			if key, value, ok := strings.Cut(param, "="); ok {
+				property.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+			}
+		}
+		properties = append(properties, property)
+	}
+	return properties
+}
+
+// parseICalEvents returns the weekly recurring events of an iCalendar file.
+// Times are converted to the given location, or kept in the time zone of the
+// event if it is nil. Events which don't recur weekly, recur only every few
+// weeks or have ended before now can't be part of a weekly schedule; their
+// summaries are returned separately.
+func parseICalEvents(content string, location *time.Location, now time.Time) ([]icalEvent, []string, error) {
+	events, skipped := []icalEvent{}, []string{}
+
+	var event map[string]icalProperty
+	for _, property := range parseICalProperties(content) {
+		switch {
+		case property.name == "BEGIN" && strings.EqualFold(property.value, "VEVENT"):
+			event = map[string]icalProperty{}
+		case property.name == "END" && strings.EqualFold(property.value, "VEVENT") && event != nil:
+			parsed, ok, err := parseICalEvent(event, location, now)
+			if err != nil {
+				return nil, nil, fmt.Errorf("event '%s': %w", event["SUMMARY"].value, err)
+			}
+			if ok {
+				events = append(events, parsed)
+			} else if !strings.EqualFold(event["STATUS"].value, "CANCELLED") {
+				skipped = append(skipped, event["SUMMARY"].value)
+			}
+			event = nil
+		case event != nil:
+			event[property.name] = property
+		}
+	}
+
+	return events, skipped, nil
+}
+
+// parseICalEvent parses a single event. The second return value is false if
+// the event is not part of a weekly schedule.
+func parseICalEvent(event map[string]icalProperty, location *time.Location, now time.Time) (icalEvent, bool, error) {
+	parsed := icalEvent{summary: event["SUMMARY"].value}
+
+	if strings.EqualFold(event["STATUS"].value, "CANCELLED") {
+		return parsed, false, nil
+	}
+
+	dtstart, ok := event["DTSTART"]
+	if !ok {
+		return parsed, false, fmt.Errorf("missing DTSTART")
+	}
+	start, allDay, err := parseICalTime(dtstart, location)
+	if err != nil {
+		return parsed, false, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+
+	switch {
+	case event["DTEND"].value != "":
+		end, _, err := parseICalTime(event["DTEND"], location)
+		if err != nil {
+			return parsed, false, fmt.Errorf("invalid DTEND: %w", err)
+		}
+		parsed.duration = int(end.Sub(start).Minutes())
+	case event["DURATION"].value != "":
+		duration, err := parseICalDuration(event["DURATION"].value)
+		if err != nil {
+			return parsed, false, fmt.Errorf("invalid DURATION: %w", err)
+		}
+		parsed.duration = int(duration.Minutes())
+	case allDay:
+		parsed.duration = minutesPerDay
+	}
+	parsed.duration = min(parsed.duration, minutesPerWeek)
+
+	rrule := map[string]string{}
+	for _, part := range strings.Split(event["RRULE"].value, ";") {
+		if key, value, ok := strings.Cut(part, "="); ok {
+			rrule[strings.ToUpper(key)] = strings.ToUpper(value)
+		}
+	}
+	if rrule["FREQ"] != "WEEKLY" || (rrule["INTERVAL"] != "" && rrule["INTERVAL"] != "1") || parsed.duration <= 0 {
+		return parsed, false, nil
+	}
+	if until := rrule["UNTIL"]; until != "" {
+		end, _, err := parseICalTime(icalProperty{value: until}, time.UTC)
+		if err != nil {
+			return parsed, false, fmt.Errorf("invalid UNTIL: %w", err)
+		}
+		if end.Before(now) {
+			return parsed, false, nil
+		}
+	}
+
+	// BYDAY refers to the days in the time zone of DTSTART. Converting the
+	// start to another time zone may move it to another day, which shifts
+	// all occurrences.
+	original, _, err := parseICalTime(dtstart, nil)
+	if err != nil {
+		return parsed, false, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+	shift := weekMinute(start) - weekMinute(original)
+
+	days := []int{weekdayIndex(original)}
+	if byDay := rrule["BYDAY"]; byDay != "" {
+		days = nil
+		for _, day := range strings.Split(byDay, ",") {
+			index := icalWeekdays[strings.TrimLeft(day, "+-0123456789")]
+			if index == 0 {
+				return parsed, false, fmt.Errorf("invalid day '%s' in BYDAY", day)
+			}
+			days = append(days, index-1)
+		}
+	}
+	for _, day := range days {
+		weekStart := day*minutesPerDay + original.Hour()*60 + original.Minute() + shift
+		parsed.starts = append(parsed.starts, ((weekStart%minutesPerWeek)+minutesPerWeek)%minutesPerWeek)
+	}
+
+	return parsed, true, nil
+}
+
+// icalWeekdays maps the days of the week in RRULEs to their index in
+// weekdayNames, plus one.
+var icalWeekdays = map[string]int{"MO": 1, "TU": 2, "WE": 3, "TH": 4, "FR": 5, "SA": 6, "SU": 7}
+
+// weekMinute returns the number of minutes since the start of the week
+// (Monday 00:00) of the given time.
+func weekMinute(t time.Time) int {
+	return weekdayIndex(t)*minutesPerDay + t.Hour()*60 + t.Minute()
+}
+
+// parseICalTime parses a DATE or DATE-TIME value. UTC times and times with a
+// TZID are converted to the given location if it is not nil, floating times
+// are always taken as they are. The second return value is true for dates
+// without a time.
+func parseICalTime(property icalProperty, location *time.Location) (time.Time, bool, error) {
+	value := property.value
+	if len(value) == len("20060102") {
+		t, err := time.Parse("20060102", value)
+		return t, true, err
+	}
+
+	eventLocation := time.UTC
+	if tzid := property.params["TZID"]; tzid != "" && !strings.HasSuffix(value, "Z") {
+		var err error
+		if eventLocation, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown time zone '%s'", tzid)
+		}
+	}
+
+	t, err := time.ParseInLocation("20060102T150405", strings.TrimSuffix(value, "Z"), eventLocation)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	floating := !strings.HasSuffix(value, "Z") && property.params["TZID"] == ""
+	if location != nil && !floating {
+		t = t.In(location)
+	}
+	return t, false, nil
+}
+
+var icalDurationRegexp = regexp.MustCompile(`^\+?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseICalDuration parses a DURATION value, e.g. 'PT1H30M'.
+func parseICalDuration(value string) (time.Duration, error) {
+	matches := icalDurationRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid duration '%s'", value)
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var duration time.Duration
+	for i, unit := range units {
+		if n, err := strconv.Atoi(matches[i+1]); err == nil {
+			duration += time.Duration(n) * unit
+		}
+	}
+	return duration, nil
+}
+
+// icalOccupancy returns the periods of each day of the week in which any of
+// the events takes place, as time blocks with the given setting. The blocks
+// of a day are ordered and adjacent or overlapping events are merged.
+func icalOccupancy(events []icalEvent, setting normalizedTimeBlock) [][]normalizedTimeBlock {
+	occupied := make([]bool, minutesPerWeek)
+	for _, event := range events {
+		for _, start := range event.starts {
+			for minute := start; minute < start+event.duration; minute++ {
+				occupied[minute%minutesPerWeek] = true
+			}
+		}
+	}
+
+	days := make([][]normalizedTimeBlock, len(weekdayNames))
+	for day := range days {
+		days[day] = []normalizedTimeBlock{}
+		for minute := 0; minute < minutesPerDay; minute++ {
+			if !occupied[day*minutesPerDay+minute] {
+				continue
+			}
+			if n := len(days[day]); n > 0 && days[day][n-1].end == minute {
+				days[day][n-1].end++
+				continue
+			}
+			block := setting
+			block.start, block.end = minute, minute+1
+			days[day] = append(days[day], block)
+		}
+	}
+	return days
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ICalScheduleDataSource{}
+
+func NewICalScheduleDataSource() datasource.DataSource {
+	return &ICalScheduleDataSource{}
+}
+
+type ICalScheduleDataSource struct{}
+
+type ICalScheduleDataSourceModel struct {
+	ID                 types.String     `tfsdk:"id"`
+	Path               types.String     `tfsdk:"path"`
+	ComfortTemperature types.Float64    `tfsdk:"comfort_temperature"`
+	EcoTemperature     types.Float64    `tfsdk:"eco_temperature"`
+	TimeZone           types.String     `tfsdk:"time_zone"`
+	Mon                []TimeBlockModel `tfsdk:"mon"`
+	Tue                []TimeBlockModel `tfsdk:"tue"`
+	Wed                []TimeBlockModel `tfsdk:"wed"`
+	Thu                []TimeBlockModel `tfsdk:"thu"`
+	Fri                []TimeBlockModel `tfsdk:"fri"`
+	Sat                []TimeBlockModel `tfsdk:"sat"`
+	Sun                []TimeBlockModel `tfsdk:"sun"`
+
+	Days     *HeatingScheduleDaysModel `tfsdk:"days"`
+	Timeouts timeouts.Value            `tfsdk:"timeouts"`
+}
+
+func (*ICalScheduleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ical_schedule"
+}
+
+func (ICalScheduleDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "A weekly heating schedule derived from the events of a local iCalendar (.ics) file. Events which recur weekly (`RRULE` with `FREQ=WEEKLY` and optionally `BYDAY`) are occupied periods, which heat to `comfort_temperature`. All other periods heat to `eco_temperature`. " +
+			"Other events can't be part of a weekly schedule and are ignored with a warning. The day attributes can be assigned to the day attributes of the `tado_heating_schedule` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Path of the iCalendar file.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the iCalendar file to read.",
+				Required:            true,
+			},
+			"comfort_temperature": schema.Float64Attribute{
+				MarkdownDescription: "Temperature during the events.",
+				Required:            true,
+			},
+			"eco_temperature": schema.Float64Attribute{
+				MarkdownDescription: "Temperature outside of the events. If not set, heating is turned off outside of the events.",
+				Optional:            true,
+			},
+			"time_zone": schema.StringAttribute{
+				MarkdownDescription: "Time zone of the schedule, e.g. 'Europe/Berlin'. Times of the events are converted to this time zone. If not set, events are taken in their own time zone.",
+				Optional:            true,
+			},
+			"mon": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Monday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"tue": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Tuesday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"wed": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Wednesday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"thu": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Thursday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"fri": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Friday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"sat": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Saturday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"sun": schema.ListNestedAttribute{
+				MarkdownDescription: "Schedule for Sunday.",
+				Computed:            true,
+				NestedObject:        timeBlockDataSourceAttributes,
+			},
+			"days": schema.SingleNestedAttribute{
+				MarkdownDescription: "Schedule for each day of the week. Can be assigned to the `days` attribute of the `tado_heating_schedule` resource.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"mon": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Monday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"tue": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Tuesday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"wed": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Wednesday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"thu": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Thursday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"fri": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Friday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"sat": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Saturday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+					"sun": schema.ListNestedAttribute{
+						MarkdownDescription: "Schedule for Sunday.",
+						Computed:            true,
+						NestedObject:        timeBlockDataSourceAttributes,
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (ICalScheduleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ICalScheduleDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var location *time.Location
+	if !data.TimeZone.IsNull() {
+		var err error
+		location, err = time.LoadLocation(data.TimeZone.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("time_zone"), "Invalid Time Zone", fmt.Sprintf("Unknown time zone '%s': %v", data.TimeZone.ValueString(), err))
+			return
+		}
+	}
+
+	content, err := os.ReadFile(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Unable to Read iCalendar File", fmt.Sprintf("Unable to read '%s': %v", data.Path.ValueString(), err))
+		return
+	}
+
+	events, skipped, err := parseICalEvents(string(content), location, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid iCalendar File", fmt.Sprintf("Unable to parse '%s': %v", data.Path.ValueString(), err))
+		return
+	}
+	if len(skipped) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Ignored Calendar Events",
+			fmt.Sprintf("The following events of '%s' don't recur every week and are not part of the schedule: '%s'.", data.Path.ValueString(), strings.Join(skipped, "', '")),
+		)
+	}
+
+	eco := normalizedTimeBlock{start: 0, end: minutesPerDay, geofencingControl: true}
+	if !data.EcoTemperature.IsNull() {
+		eco.heating, eco.temperature = true, int64(math.Round(data.EcoTemperature.ValueFloat64()*10))
+	}
+	comfort := normalizedTimeBlock{heating: true, temperature: int64(math.Round(data.ComfortTemperature.ValueFloat64() * 10)), geofencingControl: true}
+
+	days := make([][]TimeBlockModel, len(weekdayNames))
+	for day, occupied := range icalOccupancy(events, comfort) {
+		days[day] = normalizedTimeBlocksToModels(overlayTimeBlocks([]normalizedTimeBlock{eco}, occupied))
+	}
+
+	data.ID = data.Path
+	data.Days = &HeatingScheduleDaysModel{Mon: days[0], Tue: days[1], Wed: days[2], Thu: days[3], Fri: days[4], Sat: days[5], Sun: days[6]}
+	data.Mon, data.Tue, data.Wed, data.Thu, data.Fri, data.Sat, data.Sun = days[0], days[1], days[2], days[3], days[4], days[5], days[6]
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseICalEvents(t *testing.T) {
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"SUMMARY:Team meeting",
+		"DTSTART;TZID=Europe/Berlin:20240101T090000",
+		"DTEND;TZID=Europe/Berlin:20240101T120000",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO,WE",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Evening",
+		"DTSTART:20240105T210000Z",
+		"DURATION:PT4H",
+		"RRULE:FREQ=WEEKLY",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Biweekly",
+		"DTSTART:20240102T080000",
+		"DTEND:20240102T090000",
+		"RRULE:FREQ=WEEKLY;INTERVAL=2",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Ended",
+		"DTSTART:20230102T080000",
+		"DTEND:20230102T090000",
+		"RRULE:FREQ=WEEKLY;UNTIL=20230601T000000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Long folded",
+		" summary",
+		"DTSTART:20240106",
+		"RRULE:FREQ=WEEKLY",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		location *time.Location
+		expected string
+	}{
+		"own time zones": {
+			location: nil,
+			expected: "Mon 09:00-12:00, Wed 09:00-12:00, Fri 21:00-00:00, Sat 00:00-00:00",
+		},
+		"converted": {
+			location: berlin,
+			expected: "Mon 09:00-12:00, Wed 09:00-12:00, Fri 22:00-00:00, Sat 00:00-00:00",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			events, skipped, err := parseICalEvents(calendar, tc.location, now)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got := strings.Join(skipped, ", "); got != "Biweekly, Ended" {
+				t.Fatalf("Expected: Biweekly, Ended, got: %s", got)
+			}
+
+			summary := []string{}
+			for day, blocks := range icalOccupancy(events, normalizedTimeBlock{heating: true, temperature: 210}) {
+				for _, block := range blocks {
+					summary = append(summary, fmt.Sprintf("%s %s-%s", weekdayNames[day], formatTimeOfDay(block.start), formatTimeOfDay(block.end)))
+				}
+			}
+			if got := strings.Join(summary, ", "); got != tc.expected {
+				t.Fatalf("Expected: %s, got: %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseICalDuration(t *testing.T) {
+	cases := map[string]struct {
+		value    string
+		expected time.Duration
+		err      bool
+	}{
+		"hours and minutes": {value: "PT1H30M", expected: 90 * time.Minute},
+		"days":              {value: "P1D", expected: 24 * time.Hour},
+		"weeks":             {value: "P1W", expected: 7 * 24 * time.Hour},
+		"invalid":           {value: "1H", err: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			duration, err := parseICalDuration(tc.value)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("Expected: error, got: %v", duration)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if duration != tc.expected {
+				t.Fatalf("Expected: %v, got: %v", tc.expected, duration)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// getHome returns the home with the given ID. If the ID is null or unknown,
+// the home is looked up by its name instead.
+func getHome(ctx context.Context, me *gotado.User, id types.Int64, name types.String) (*gotado.Home, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	if id.IsNull() || id.IsUnknown() {
+		home, err := me.GetHome(ctx, name.ValueString())
+		if err != nil {
+			diags.AddError("Tado API Error", fmt.Sprintf("Unable to get home '%s': %v", name.ValueString(), err))
+			return nil, diags
+		}
+		return home, diags
+	}
+
+	for _, userHome := range me.Homes {
+		if int64(userHome.ID) != id.ValueInt64() {
+			continue
+		}
+		home, err := me.GetHome(ctx, userHome.Name)
+		if err != nil {
+			diags.AddError("Tado API Error", fmt.Sprintf("Unable to get home with ID %d: %v", id.ValueInt64(), err))
+			return nil, diags
+		}
+		return home, diags
+	}
+
+	diags.AddError("Tado API Error", fmt.Sprintf("Unable to get home with ID %d: unknown home ID", id.ValueInt64()))
+	return nil, diags
+}
+
+// getZone returns the zone of a home with the given ID. If the ID is null or
+// unknown, the zone is looked up by its name instead.
+func getZone(ctx context.Context, home *gotado.Home, id types.Int64, name types.String) (*gotado.Zone, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	if id.IsNull() || id.IsUnknown() {
+		zone, err := home.GetZone(ctx, name.ValueString())
+		if err != nil {
+			diags.AddError("Tado API Error", fmt.Sprintf("Unable to get zone '%s': %v", name.ValueString(), err))
+			return nil, diags
+		}
+		return zone, diags
+	}
+
+	zones, err := home.GetZones(ctx)
+	if err != nil {
+		diags.AddError("Tado API Error", fmt.Sprintf("Unable to get zone with ID %d: %v", id.ValueInt64(), err))
+		return nil, diags
+	}
+	for _, zone := range zones {
+		if int64(zone.ID) == id.ValueInt64() {
+			return zone, diags
+		}
+	}
+
+	diags.AddError("Tado API Error", fmt.Sprintf("Unable to get zone with ID %d: unknown zone ID in home '%s'", id.ValueInt64(), home.Name))
+	return nil, diags
+}
+
+// checkName checks that the configured name of a home or zone, if any,
+// matches its actual name. This catches configurations in which name and ID
+// refer to different objects.
+func checkName(p path.Path, kind string, configured types.String, actual string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if configured.IsNull() || configured.IsUnknown() || configured.ValueString() == actual {
+		return diags
+	}
+	diags.AddAttributeError(
+		p,
+		fmt.Sprintf("Mismatching %s Name", kind),
+		fmt.Sprintf("The %s with the configured ID is named '%s', not '%s'. Names can't be changed through the tado API, so either use the actual name or only set the ID.", kind, actual, configured.ValueString()),
+	)
+	return diags
+}
+
+// parseImportIdentifier parses an identifier of an import ID, which is either
+// a numeric ID or a name.
+func parseImportIdentifier(s string) (types.Int64, types.String) {
+	if id, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return types.Int64Value(id), types.StringNull()
+	}
+	return types.Int64Null(), types.StringValue(s)
+}
+
+// useStateForUnknownUnlessChanged returns a plan modifier which, like
+// UseStateForUnknown, copies the prior state value of a computed attribute
+// into the plan. The value is only kept as long as none of the given
+// attributes has been changed in the configuration. This keeps the ID and
+// name of a home or zone stable, while still resolving them again once the
+// resource refers to a different home or zone.
+func useStateForUnknownUnlessChanged(paths ...path.Path) useStateForUnknownUnlessChangedModifier {
+	return useStateForUnknownUnlessChangedModifier{paths: paths}
+}
+
+type useStateForUnknownUnlessChangedModifier struct {
+	paths []path.Path
+}
+
+var _ planmodifier.Int64 = useStateForUnknownUnlessChangedModifier{}
+var _ planmodifier.String = useStateForUnknownUnlessChangedModifier{}
+
+func (m useStateForUnknownUnlessChangedModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change as long as the attributes it depends on are unchanged."
+}
+
+func (m useStateForUnknownUnlessChangedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForUnknownUnlessChangedModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if m.unchanged(ctx, req.Config, req.State, &resp.Diagnostics) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (m useStateForUnknownUnlessChangedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if m.unchanged(ctx, req.Config, req.State, &resp.Diagnostics) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// unchanged checks that all attributes of the modifier are either not
+// configured or configured to their prior state value.
+func (m useStateForUnknownUnlessChangedModifier) unchanged(ctx context.Context, config tfsdk.Config, state tfsdk.State, diags *diag.Diagnostics) bool {
+	for _, p := range m.paths {
+		var configValue, stateValue attr.Value
+		diags.Append(config.GetAttribute(ctx, p, &configValue)...)
+		diags.Append(state.GetAttribute(ctx, p, &stateValue)...)
+		if diags.HasError() {
+			return false
+		}
+		if configValue.IsNull() {
+			continue
+		}
+		if configValue.IsUnknown() || !configValue.Equal(stateValue) {
+			return false
+		}
+	}
+	return true
+}
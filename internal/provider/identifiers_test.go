@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseImportIdentifier(t *testing.T) {
+	cases := map[string]struct {
+		input        string
+		expectedID   types.Int64
+		expectedName types.String
+	}{
+		"numeric id": {
+			input:        "123456",
+			expectedID:   types.Int64Value(123456),
+			expectedName: types.StringNull(),
+		},
+		"name": {
+			input:        "My Home",
+			expectedID:   types.Int64Null(),
+			expectedName: types.StringValue("My Home"),
+		},
+		"name starting with digits": {
+			input:        "2nd Floor",
+			expectedID:   types.Int64Null(),
+			expectedName: types.StringValue("2nd Floor"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, name := parseImportIdentifier(tc.input)
+			if !id.Equal(tc.expectedID) {
+				t.Fatalf("Expected: %s, got: %s", tc.expectedID, id)
+			}
+			if !name.Equal(tc.expectedName) {
+				t.Fatalf("Expected: %s, got: %s", tc.expectedName, name)
+			}
+		})
+	}
+}
+
+func TestCheckName(t *testing.T) {
+	cases := map[string]struct {
+		configured types.String
+		wantErr    bool
+	}{
+		"not configured": {
+			configured: types.StringNull(),
+		},
+		"unknown": {
+			configured: types.StringUnknown(),
+		},
+		"matching": {
+			configured: types.StringValue("Living Room"),
+		},
+		"mismatching": {
+			configured: types.StringValue("Kitchen"),
+			wantErr:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := checkName(path.Root("zone_name"), "Zone", tc.configured, "Living Room")
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("Expected: error %t, got: %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
@@ -3,12 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/cli/browser"
 	"github.com/gonzolino/gotado/v2"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +27,7 @@ const (
 
 // Ensure TadoProvider satisfies various provider interfaces.
 var _ provider.Provider = &TadoProvider{}
+var _ provider.ProviderWithFunctions = &TadoProvider{}
 
 // TadoProvider defines the provider implementation.
 type TadoProvider struct {
@@ -33,15 +39,27 @@ type TadoProvider struct {
 
 // TadoProviderModel describes the provider data model.
 type TadoProviderModel struct {
-	TokenPath types.String `tfsdk:"token_path"`
+	TokenPath           types.String `tfsdk:"token_path"`
+	RequestTimeout      types.String `tfsdk:"request_timeout"`
+	ConvergenceTimeout  types.String `tfsdk:"convergence_timeout"`
+	ConvergenceInterval types.String `tfsdk:"convergence_interval"`
 }
 
 // tadoProviderData contains data needed to configure tado resources and data
 // sources.
 type tadoProviderData struct {
-	config    *oauth2.Config
-	token     *oauth2.Token
-	tokenPath string
+	config         *oauth2.Config
+	token          *oauth2.Token
+	tokenPath      string
+	requestTimeout time.Duration
+	convergence    convergence
+}
+
+// newClient creates a tado client whose requests time out after the
+// configured request timeout. Refreshed tokens are written to the token path.
+func (d *tadoProviderData) newClient(ctx context.Context, diagnostics *diag.Diagnostics) *gotado.Tado {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Timeout: d.requestTimeout})
+	return gotado.NewWithTokenRefreshCallback(ctx, d.config, d.token, createTokenUpdateCallback(d.tokenPath, diagnostics))
 }
 
 func (p *TadoProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -55,12 +73,26 @@ func (*TadoProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *p
 The Tado provider is used to manage your Tado home.
 
 While not everything is supported yet, the provider is able to manage heating schedules and settings such as geofencing.
+
+All resources and data sources accept a ` + "`timeouts`" + ` block. Unless configured there, creating, updating and deleting a resource times out after 10 minutes and reading after 5 minutes.
 `,
 		Attributes: map[string]schema.Attribute{
 			"token_path": schema.StringAttribute{
 				MarkdownDescription: "The path where to store the Tado token. This can also be configured via the `TADO_TOKEN_PATH` environment variable. If neither this attribute nor the environment variable is set, the default location `~/.tado_token.json` is used.",
 				Optional:            true,
 			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long a single request to the Tado API may take, e.g. '1m'. Defaults to '30s'. Set to '0s' to disable the timeout.",
+				Optional:            true,
+			},
+			"convergence_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long resources wait for the Tado API to reflect a change they made, e.g. '1m'. Right after a change, Tado sometimes still returns the previous value. Defaults to '30s'. Set to '0s' to read the result only once.",
+				Optional:            true,
+			},
+			"convergence_interval": schema.StringAttribute{
+				MarkdownDescription: "How often resources read the result of a change while waiting for the Tado API to reflect it, e.g. '5s'. Defaults to '2s'.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -92,6 +124,47 @@ func (*TadoProvider) Configure(ctx context.Context, req provider.ConfigureReques
 		tokenPath = filepath.Join(home, ".tado_token.json")
 	}
 
+	requestTimeout := defaultRequestTimeout
+	if !data.RequestTimeout.IsNull() {
+		timeout, err := time.ParseDuration(data.RequestTimeout.ValueString())
+		if err != nil || timeout < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid request timeout",
+				fmt.Sprintf("Invalid duration '%s', must be zero or more, e.g. '30s'.", data.RequestTimeout.ValueString()),
+			)
+		}
+		requestTimeout = timeout
+	}
+
+	convergence := defaultConvergence
+	if !data.ConvergenceTimeout.IsNull() {
+		timeout, err := time.ParseDuration(data.ConvergenceTimeout.ValueString())
+		if err != nil || timeout < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("convergence_timeout"),
+				"Invalid convergence timeout",
+				fmt.Sprintf("Invalid duration '%s', must be zero or more, e.g. '30s'.", data.ConvergenceTimeout.ValueString()),
+			)
+		}
+		convergence.timeout = timeout
+	}
+	if !data.ConvergenceInterval.IsNull() {
+		interval, err := time.ParseDuration(data.ConvergenceInterval.ValueString())
+		if err != nil || interval <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("convergence_interval"),
+				"Invalid convergence interval",
+				fmt.Sprintf("Invalid duration '%s', must be more than zero, e.g. '2s'.", data.ConvergenceInterval.ValueString()),
+			)
+		}
+		convergence.interval = interval
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	token, err := readToken(tokenPath)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -102,6 +175,7 @@ func (*TadoProvider) Configure(ctx context.Context, req provider.ConfigureReques
 	}
 
 	config := gotado.AuthConfig(tadoClientID, "offline_access")
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Timeout: requestTimeout})
 
 	if token == nil {
 		deviceAuth, err := config.DeviceAuth(ctx)
@@ -131,9 +205,11 @@ func (*TadoProvider) Configure(ctx context.Context, req provider.ConfigureReques
 	}
 
 	providerData := &tadoProviderData{
-		config:    config,
-		token:     token,
-		tokenPath: tokenPath,
+		config:         config,
+		token:          token,
+		tokenPath:      tokenPath,
+		requestTimeout: requestTimeout,
+		convergence:    convergence,
 	}
 
 	resp.DataSourceData = providerData
@@ -143,14 +219,35 @@ func (*TadoProvider) Configure(ctx context.Context, req provider.ConfigureReques
 func (*TadoProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewGeofencingResource,
+		NewHeatingScheduleGroupResource,
 		NewHeatingScheduleResource,
 	}
 }
 
 func (*TadoProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewHeatingScheduleDataSource,
 		NewHomeDataSource,
+		NewHomeStateDataSource,
+		NewICalScheduleDataSource,
+		NewScheduleFileDataSource,
+		NewScheduleTimelineDataSource,
+		NewSunTimesDataSource,
+		NewWeatherDataSource,
 		NewZoneDataSource,
+		NewZoneCapabilitiesDataSource,
+		NewZoneStateDataSource,
+	}
+}
+
+func (*TadoProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewScheduleFunction,
+		NewScheduleAtFunction,
+		NewScheduleMergeFunction,
+		NewScheduleOffsetFunction,
+		NewScheduleOverrideFunction,
+		NewScheduleValidateFunction,
 	}
 }
 
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// minutesPerDay is the number of minutes in a day. An end time of '00:00'
+// corresponds to minutesPerDay, i.e. the end of the day.
+const minutesPerDay = 24 * 60
+
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):([0-5][0-9])$`)
+
+// parseTimeOfDay parses a time of day in the format 'hh:mm' and returns the
+// number of minutes since midnight.
+func parseTimeOfDay(s string) (int, error) {
+	matches := timeOfDayRegexp.FindStringSubmatch(s)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid time '%s', format must be 'hh:mm'", s)
+	}
+	hours, _ := strconv.Atoi(matches[1])
+	minutes, _ := strconv.Atoi(matches[2])
+	return hours*60 + minutes, nil
+}
+
+// endOfDay is an alternative notation for the end of the day, which tado
+// stores as '00:00'.
+const endOfDay = "24:00"
+
+// parseEndOfBlock parses the end time of a time block. Other than start times,
+// an end time of '00:00' or '24:00' means the end of the day.
+func parseEndOfBlock(s string) (int, error) {
+	if s == endOfDay {
+		return minutesPerDay, nil
+	}
+	minutes, err := parseTimeOfDay(s)
+	if err != nil {
+		return 0, err
+	}
+	if minutes == 0 {
+		return minutesPerDay, nil
+	}
+	return minutes, nil
+}
+
+// normalizeEndOfBlock returns the end time of a time block as stored by tado.
+func normalizeEndOfBlock(s string) string {
+	if s == endOfDay {
+		return "00:00"
+	}
+	return s
+}
+
+// formatTimeOfDay formats the number of minutes since midnight as 'hh:mm'.
+// The end of the day is formatted as '00:00'.
+func formatTimeOfDay(minutes int) string {
+	minutes %= minutesPerDay
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// validateTimeBlocks checks that the time blocks of a single day (or group of
+// days) are valid: all times must be in the format 'hh:mm', the blocks must
+// be ordered and cover the whole day from 00:00 to 00:00 without gaps or
+// overlaps, and blocks which turn heating on must have a temperature.
+// Unknown values are skipped. Diagnostics point at the offending attribute
+// below the given path.
+func validateTimeBlocks(p path.Path, blocks []TimeBlockModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if len(blocks) == 0 {
+		diags.AddAttributeError(p, "Invalid Schedule", "The schedule must contain at least one time block.")
+		return diags
+	}
+
+	// previousEnd is the end of the previous block, or -1 if it is unknown.
+	previousEnd := 0
+	for i, block := range blocks {
+		blockPath := p.AtListIndex(i)
+
+		start := -1
+		if !block.Start.IsUnknown() && !block.Start.IsNull() {
+			minutes, err := parseTimeOfDay(block.Start.ValueString())
+			if err != nil {
+				diags.AddAttributeError(blockPath.AtName("start"), "Invalid Time", fmt.Sprintf("Unable to parse start of time block: %v.", err))
+			} else {
+				start = minutes
+			}
+		}
+
+		end := -1
+		if !block.End.IsUnknown() && !block.End.IsNull() {
+			minutes, err := parseEndOfBlock(block.End.ValueString())
+			if err != nil {
+				diags.AddAttributeError(blockPath.AtName("end"), "Invalid Time", fmt.Sprintf("Unable to parse end of time block: %v.", err))
+			} else {
+				end = minutes
+			}
+		}
+
+		if start >= 0 && previousEnd >= 0 {
+			switch {
+			case i == 0 && start != 0:
+				diags.AddAttributeError(blockPath.AtName("start"), "Invalid Schedule", fmt.Sprintf("The first time block must start at 00:00, not at %s.", formatTimeOfDay(start)))
+			case start > previousEnd:
+				diags.AddAttributeError(blockPath.AtName("start"), "Invalid Schedule", fmt.Sprintf("Gap between %s and %s: the time block must start when the previous block ends.", formatTimeOfDay(previousEnd), formatTimeOfDay(start)))
+			case start < previousEnd:
+				diags.AddAttributeError(blockPath.AtName("start"), "Invalid Schedule", fmt.Sprintf("The time block overlaps with the previous block, which ends at %s.", formatTimeOfDay(previousEnd)))
+			}
+		}
+
+		if start >= 0 && end >= 0 && end <= start {
+			diags.AddAttributeError(blockPath.AtName("end"), "Invalid Schedule", fmt.Sprintf("The time block must end after it starts at %s.", formatTimeOfDay(start)))
+		}
+
+		if block.Heating.ValueBool() && block.Temperature.IsNull() {
+			diags.AddAttributeError(blockPath.AtName("temperature"), "Missing Temperature", "The temperature is required when 'heating' is true.")
+		}
+
+		previousEnd = end
+	}
+
+	if previousEnd >= 0 && previousEnd != minutesPerDay {
+		diags.AddAttributeError(p.AtListIndex(len(blocks)-1).AtName("end"), "Invalid Schedule", fmt.Sprintf("The last time block must end at 00:00, not at %s.", formatTimeOfDay(previousEnd)))
+	}
+
+	return diags
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ScheduleAtFunction{}
+
+func NewScheduleAtFunction() function.Function {
+	return &ScheduleAtFunction{}
+}
+
+// ScheduleAtFunction returns the setpoint of a schedule at a time of day.
+type ScheduleAtFunction struct{}
+
+func (*ScheduleAtFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_at"
+}
+
+func (*ScheduleAtFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Return the setpoint of a schedule at a time of day",
+		MarkdownDescription: "Returns the temperature which the time blocks of a day set at the given time, or `null` if heating is off at that time.",
+		Parameters: []function.Parameter{
+			scheduleParameter("schedule", "Time blocks of a day, either as a list like the day attributes of `tado_heating_schedule` or as a compact schedule like the argument of `schedule`."),
+			function.StringParameter{
+				Name:                "time",
+				MarkdownDescription: "Time of day in the format 'hh:mm'.",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+func (*ScheduleAtFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule types.Dynamic
+	var timeOfDay string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &schedule, &timeOfDay))
+	if resp.Error != nil {
+		return
+	}
+
+	blocks, funcErr := scheduleArgument(schedule, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	minute, err := parseTimeOfDay(timeOfDay)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid time: %v.", err))
+		return
+	}
+
+	setpoint := types.Float64Null()
+	if block, ok := timeBlockAt(blocks, minute); ok && block.heating {
+		setpoint = types.Float64Value(float64(block.temperature) / 10)
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, setpoint))
+}
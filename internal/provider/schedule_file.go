@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scheduleFileDays maps the day keys of a schedule file to the days of the
+// week they stand for, as indices in weekdayNames.
+var scheduleFileDays = map[string][]int{
+	"mon_sun": {0, 1, 2, 3, 4, 5, 6},
+	"mon_fri": {0, 1, 2, 3, 4},
+	"mon":     {0},
+	"tue":     {1},
+	"wed":     {2},
+	"thu":     {3},
+	"fri":     {4},
+	"sat":     {5},
+	"sun":     {6},
+}
+
+// scheduleFileColumns are the columns of a CSV schedule file. The
+// geofencing_control column is optional.
+var scheduleFileColumns = []string{"zone", "day", "start", "end", "heating", "temperature", "geofencing_control"}
+
+// scheduleFileBuilder collects the time blocks of the zones of a schedule
+// file.
+type scheduleFileBuilder struct {
+	zones map[string]*[7][]TimeBlockModel
+	// keys are the day keys each day of a zone was defined with.
+	keys map[string]*[7]string
+}
+
+// add appends a time block to the days of a zone the day key stands for.
+func (b *scheduleFileBuilder) add(zone, key string, block TimeBlockModel) error {
+	days, ok := scheduleFileDays[strings.ToLower(key)]
+	if !ok {
+		return fmt.Errorf("invalid day '%s', must be one of 'mon_sun', 'mon_fri', 'mon', 'tue', 'wed', 'thu', 'fri', 'sat' or 'sun'", key)
+	}
+	if b.zones[zone] == nil {
+		b.zones[zone], b.keys[zone] = &[7][]TimeBlockModel{}, &[7]string{}
+	}
+	for _, day := range days {
+		if previous := b.keys[zone][day]; previous != "" && previous != strings.ToLower(key) {
+			return fmt.Errorf("zone '%s' defines %s with both '%s' and '%s'", zone, weekdayNames[day], previous, strings.ToLower(key))
+		}
+		b.keys[zone][day] = strings.ToLower(key)
+		b.zones[zone][day] = append(b.zones[zone][day], block)
+	}
+	return nil
+}
+
+// build validates the schedule of each zone like tado_heating_schedule does
+// and returns the time blocks of each day of the week per zone.
+func (b *scheduleFileBuilder) build() (map[string]HeatingScheduleDaysModel, error) {
+	if len(b.zones) == 0 {
+		return nil, fmt.Errorf("the file doesn't contain any time blocks")
+	}
+
+	zoneNames := make([]string, 0, len(b.zones))
+	for zone := range b.zones {
+		zoneNames = append(zoneNames, zone)
+	}
+	sort.Strings(zoneNames)
+
+	problems := []string{}
+	zones := make(map[string]HeatingScheduleDaysModel, len(b.zones))
+	for _, zone := range zoneNames {
+		days := b.zones[zone]
+		for day, blocks := range days {
+			if len(blocks) == 0 {
+				problems = append(problems, fmt.Sprintf("zone '%s' has no schedule for %s.", zone, weekdayNames[day]))
+				continue
+			}
+			// Days which share a key share their blocks, report their
+			// problems only once.
+			if slices.Index(b.keys[zone][:], b.keys[zone][day]) != day {
+				continue
+			}
+			for _, problem := range timeBlockProblems(blocks) {
+				problems = append(problems, fmt.Sprintf("zone '%s', %s%s", zone, b.keys[zone][day], problem))
+			}
+		}
+		zones[zone] = HeatingScheduleDaysModel{Mon: days[0], Tue: days[1], Wed: days[2], Thu: days[3], Fri: days[4], Sat: days[5], Sun: days[6]}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(problems, "\n"))
+	}
+	return zones, nil
+}
+
+// parseScheduleFileCSV parses a CSV schedule file. The first line must name
+// the columns, which may be given in any order.
+func parseScheduleFileCSV(content []byte) (map[string]HeatingScheduleDaysModel, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(scheduleFileColumns, name) {
+			return nil, fmt.Errorf("line 1: unknown column '%s', must be one of '%s'", name, strings.Join(scheduleFileColumns, "', '"))
+		}
+		columns[name] = i
+	}
+	for _, name := range scheduleFileColumns[:len(scheduleFileColumns)-1] {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("line 1: missing column '%s'", name)
+		}
+	}
+
+	builder := scheduleFileBuilder{zones: map[string]*[7][]TimeBlockModel{}, keys: map[string]*[7]string{}}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		block, err := scheduleFileTimeBlock(field("start"), field("end"), field("heating"), field("temperature"), field("geofencing_control"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := builder.add(field("zone"), field("day"), block); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+
+	return builder.build()
+}
+
+// scheduleFileTimeBlock converts the fields of a CSV line to a time block.
+// Times are validated together with the other blocks of the day.
+func scheduleFileTimeBlock(start, end, heating, temperature, geofencingControl string) (TimeBlockModel, error) {
+	block := TimeBlockModel{
+		Heating:           types.BoolNull(),
+		Temperature:       types.Float64Null(),
+		Start:             types.StringValue(start),
+		End:               types.StringValue(normalizeEndOfBlock(end)),
+		GeofencingControl: types.BoolValue(true),
+	}
+
+	value, err := strconv.ParseBool(heating)
+	if err != nil {
+		return block, fmt.Errorf("invalid heating '%s', must be 'true' or 'false'", heating)
+	}
+	block.Heating = types.BoolValue(value)
+
+	if temperature != "" && value {
+		value, err := strconv.ParseFloat(temperature, 64)
+		if err != nil {
+			return block, fmt.Errorf("invalid temperature '%s'", temperature)
+		}
+		block.Temperature = types.Float64Value(value)
+	}
+
+	if geofencingControl != "" {
+		value, err := strconv.ParseBool(geofencingControl)
+		if err != nil {
+			return block, fmt.Errorf("invalid geofencing_control '%s', must be 'true' or 'false'", geofencingControl)
+		}
+		block.GeofencingControl = types.BoolValue(value)
+	}
+
+	return block, nil
+}
+
+// scheduleFileJSONBlock is a time block of a JSON schedule file.
+type scheduleFileJSONBlock struct {
+	Start             string   `json:"start"`
+	End               string   `json:"end"`
+	Heating           *bool    `json:"heating"`
+	Temperature       *float64 `json:"temperature"`
+	GeofencingControl *bool    `json:"geofencing_control"`
+}
+
+// parseScheduleFileJSON parses a JSON schedule file, which maps zone names to
+// objects of day keys and their time blocks.
+func parseScheduleFileJSON(content []byte) (map[string]HeatingScheduleDaysModel, error) {
+	var file map[string]map[string][]scheduleFileJSONBlock
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		return nil, err
+	}
+
+	zoneNames := make([]string, 0, len(file))
+	for zone := range file {
+		zoneNames = append(zoneNames, zone)
+	}
+	sort.Strings(zoneNames)
+
+	builder := scheduleFileBuilder{zones: map[string]*[7][]TimeBlockModel{}, keys: map[string]*[7]string{}}
+	for _, zone := range zoneNames {
+		keys := make([]string, 0, len(file[zone]))
+		for key := range file[zone] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			for i, jsonBlock := range file[zone][key] {
+				if jsonBlock.Heating == nil {
+					return nil, fmt.Errorf("zone '%s', %s[%d].heating: attribute is required", zone, key, i)
+				}
+				block := TimeBlockModel{
+					Heating:           types.BoolValue(*jsonBlock.Heating),
+					Temperature:       types.Float64Null(),
+					Start:             types.StringValue(jsonBlock.Start),
+					End:               types.StringValue(normalizeEndOfBlock(jsonBlock.End)),
+					GeofencingControl: types.BoolValue(true),
+				}
+				if jsonBlock.Temperature != nil && *jsonBlock.Heating {
+					block.Temperature = types.Float64Value(*jsonBlock.Temperature)
+				}
+				if jsonBlock.GeofencingControl != nil {
+					block.GeofencingControl = types.BoolValue(*jsonBlock.GeofencingControl)
+				}
+				if err := builder.add(zone, key, block); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return builder.build()
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ScheduleFileDataSource{}
+
+const (
+	scheduleFileFormatCSV  = "csv"
+	scheduleFileFormatJSON = "json"
+)
+
+func NewScheduleFileDataSource() datasource.DataSource {
+	return &ScheduleFileDataSource{}
+}
+
+type ScheduleFileDataSource struct{}
+
+type ScheduleFileDataSourceModel struct {
+	ID       types.String                        `tfsdk:"id"`
+	Path     types.String                        `tfsdk:"path"`
+	Format   types.String                        `tfsdk:"format"`
+	Zones    map[string]HeatingScheduleDaysModel `tfsdk:"zones"`
+	Timeouts timeouts.Value                      `tfsdk:"timeouts"`
+}
+
+func (*ScheduleFileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_file"
+}
+
+func (ScheduleFileDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Heating schedules of many zones, read from a local CSV or JSON file. The schedule of each zone is validated like the `tado_heating_schedule` resource validates its day attributes. " +
+			"Days are given as 'mon_sun', 'mon_fri', 'mon', 'tue', 'wed', 'thu', 'fri', 'sat' or 'sun', and every zone must have a schedule for each day of the week.\n\n" +
+			"A CSV file starts with a header line naming the columns `zone`, `day`, `start`, `end`, `heating`, `temperature` and optionally `geofencing_control`, followed by one line per time block. " +
+			"A JSON file maps zone names to objects, which map days to lists of time blocks with the same attributes as in the `tado_heating_schedule` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Path of the schedule file.",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the schedule file to read.",
+				Required:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Format of the schedule file. Either 'csv' or 'json'. Defaults to the extension of the file.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(scheduleFileFormatCSV, scheduleFileFormatJSON),
+				},
+			},
+			"zones": schema.MapNestedAttribute{
+				MarkdownDescription: "Schedule for each day of the week, keyed by zone name. Each schedule can be assigned to the `days` attribute of the `tado_heating_schedule` resource.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"mon": schema.ListNestedAttribute{
+							MarkdownDescription: "Schedule for Monday.",
+							Computed:            true,
+							NestedObject:        timeBlockDataSourceAttributes,
+						},
+						"tue": schema.ListNestedAttribute{
+							MarkdownDescription: "Schedule for Tuesday.",
+							Computed:            true,
+							NestedObject:        timeBlockDataSourceAttributes,
+						},
+						"wed": schema.ListNestedAttribute{
+							MarkdownDescription: "Schedule for Wednesday.",
+							Computed:            true,
+							NestedObject:        timeBlockDataSourceAttributes,
+						},
+						"thu": schema.ListNestedAttribute{
+							MarkdownDescription: "Schedule for Thursday.",
+							Computed:            true,
+							NestedObject:        timeBlockDataSourceAttributes,
+						},
+						"fri": schema.ListNestedAttribute{
+							MarkdownDescription: "Schedule for Friday.",
+							Computed:            true,
+							NestedObject:        timeBlockDataSourceAttributes,
+						},
+						"sat": schema.ListNestedAttribute{
+							MarkdownDescription: "Schedule for Saturday.",
+							Computed:            true,
+							NestedObject:        timeBlockDataSourceAttributes,
+						},
+						"sun": schema.ListNestedAttribute{
+							MarkdownDescription: "Schedule for Sunday.",
+							Computed:            true,
+							NestedObject:        timeBlockDataSourceAttributes,
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (ScheduleFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ScheduleFileDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	format := data.Format.ValueString()
+	if data.Format.IsNull() {
+		format = strings.ToLower(strings.TrimPrefix(filepath.Ext(data.Path.ValueString()), "."))
+		if format != scheduleFileFormatCSV && format != scheduleFileFormatJSON {
+			resp.Diagnostics.AddAttributeError(path.Root("format"), "Unknown Schedule File Format", fmt.Sprintf("Unable to tell the format of '%s' from its extension. Please set 'format' to 'csv' or 'json'.", data.Path.ValueString()))
+			return
+		}
+	}
+
+	content, err := os.ReadFile(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Unable to Read Schedule File", fmt.Sprintf("Unable to read '%s': %v", data.Path.ValueString(), err))
+		return
+	}
+
+	var zones map[string]HeatingScheduleDaysModel
+	if format == scheduleFileFormatCSV {
+		zones, err = parseScheduleFileCSV(content)
+	} else {
+		zones, err = parseScheduleFileJSON(content)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid Schedule File", fmt.Sprintf("Invalid schedule file '%s':\n%v", data.Path.ValueString(), err))
+		return
+	}
+
+	data.ID = data.Path
+	data.Format = types.StringValue(format)
+	data.Zones = zones
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
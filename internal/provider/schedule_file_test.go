@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParseScheduleFile(t *testing.T) {
+	summarize := func(zones map[string]HeatingScheduleDaysModel) string {
+		summary := []string{}
+		for _, zone := range []string{"Kitchen", "Office"} {
+			days, ok := zones[zone]
+			if !ok {
+				continue
+			}
+			for day, blocks := range daysModelBlocks(days) {
+				parts := []string{}
+				for _, block := range blocks {
+					setting := "off"
+					if block.Heating.ValueBool() {
+						setting = fmt.Sprintf("%g", block.Temperature.ValueFloat64())
+					}
+					parts = append(parts, fmt.Sprintf("%s-%s %s", block.Start.ValueString(), block.End.ValueString(), setting))
+				}
+				summary = append(summary, fmt.Sprintf("%s %s: %s", zone, weekdayNames[day], strings.Join(parts, ", ")))
+			}
+		}
+		return strings.Join(summary, "\n")
+	}
+
+	expected := strings.Join([]string{
+		"Kitchen Mon: 00:00-00:00 19",
+		"Kitchen Tue: 00:00-00:00 19",
+		"Kitchen Wed: 00:00-00:00 19",
+		"Kitchen Thu: 00:00-00:00 19",
+		"Kitchen Fri: 00:00-00:00 19",
+		"Kitchen Sat: 00:00-00:00 19",
+		"Kitchen Sun: 00:00-00:00 19",
+		"Office Mon: 00:00-08:00 off, 08:00-17:00 21, 17:00-00:00 off",
+		"Office Tue: 00:00-08:00 off, 08:00-17:00 21, 17:00-00:00 off",
+		"Office Wed: 00:00-08:00 off, 08:00-17:00 21, 17:00-00:00 off",
+		"Office Thu: 00:00-08:00 off, 08:00-17:00 21, 17:00-00:00 off",
+		"Office Fri: 00:00-08:00 off, 08:00-17:00 21, 17:00-00:00 off",
+		"Office Sat: 00:00-00:00 off",
+		"Office Sun: 00:00-00:00 off",
+	}, "\n")
+
+	cases := map[string]struct {
+		format  string
+		content string
+		err     string
+	}{
+		"csv": {
+			format: scheduleFileFormatCSV,
+			content: "zone,day,start,end,heating,temperature\n" +
+				"Kitchen,mon_sun,00:00,24:00,true,19\n" +
+				"Office,mon_fri,00:00,08:00,false,\n" +
+				"Office,mon_fri,08:00,17:00,true,21\n" +
+				"Office,mon_fri,17:00,00:00,false,\n" +
+				"Office,sat,00:00,00:00,false,\n" +
+				"Office,sun,00:00,00:00,false,\n",
+		},
+		"json": {
+			format: scheduleFileFormatJSON,
+			content: `{
+				"Kitchen": {"mon_sun": [{"start": "00:00", "end": "00:00", "heating": true, "temperature": 19}]},
+				"Office": {
+					"mon_fri": [
+						{"start": "00:00", "end": "08:00", "heating": false},
+						{"start": "08:00", "end": "17:00", "heating": true, "temperature": 21},
+						{"start": "17:00", "end": "00:00", "heating": false}
+					],
+					"sat": [{"start": "00:00", "end": "00:00", "heating": false}],
+					"sun": [{"start": "00:00", "end": "00:00", "heating": false}]
+				}
+			}`,
+		},
+		"csv missing column": {
+			format:  scheduleFileFormatCSV,
+			content: "zone,day,start,end,heating\n",
+			err:     "line 1: missing column 'temperature'",
+		},
+		"csv invalid heating": {
+			format:  scheduleFileFormatCSV,
+			content: "zone,day,start,end,heating,temperature\nKitchen,mon_sun,00:00,00:00,yes,19\n",
+			err:     "line 2: invalid heating 'yes', must be 'true' or 'false'",
+		},
+		"csv conflicting days": {
+			format:  scheduleFileFormatCSV,
+			content: "zone,day,start,end,heating,temperature\nKitchen,mon_sun,00:00,00:00,true,19\nKitchen,mon,00:00,00:00,true,19\n",
+			err:     "line 3: zone 'Kitchen' defines Mon with both 'mon_sun' and 'mon'",
+		},
+		"json missing day": {
+			format:  scheduleFileFormatJSON,
+			content: `{"Kitchen": {"mon_fri": [{"start": "00:00", "end": "00:00", "heating": true, "temperature": 19}]}}`,
+			err:     "zone 'Kitchen' has no schedule for Sat.\nzone 'Kitchen' has no schedule for Sun.",
+		},
+		"json invalid schedule": {
+			format:  scheduleFileFormatJSON,
+			content: `{"Kitchen": {"mon_sun": [{"start": "00:00", "end": "08:00", "heating": false}, {"start": "09:00", "end": "00:00", "heating": true}]}}`,
+			err:     "zone 'Kitchen', mon_sun[1].start: Gap between 08:00 and 09:00: the time block must start when the previous block ends.\nzone 'Kitchen', mon_sun[1].temperature: The temperature is required when 'heating' is true.",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var zones map[string]HeatingScheduleDaysModel
+			var err error
+			if tc.format == scheduleFileFormatCSV {
+				zones, err = parseScheduleFileCSV([]byte(tc.content))
+			} else {
+				zones, err = parseScheduleFileJSON([]byte(tc.content))
+			}
+
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("Expected: %s, got: %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got := summarize(zones); got != expected {
+				t.Fatalf("Expected: %s, got: %s", expected, got)
+			}
+		})
+	}
+}
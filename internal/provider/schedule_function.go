@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ScheduleFunction{}
+
+func NewScheduleFunction() function.Function {
+	return &ScheduleFunction{}
+}
+
+// ScheduleFunction expands a compact schedule string to a list of time blocks.
+type ScheduleFunction struct{}
+
+func (*ScheduleFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule"
+}
+
+func (*ScheduleFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Expand a compact schedule to a list of time blocks",
+		MarkdownDescription: "Expands a compact schedule such as `\"06:00-09:00@20.5, 17:00-22:00@21\"` to the list of time blocks of a day, as used by the day attributes of `tado_heating_schedule` and `tado_heating_schedule_group`. " +
+			"Time blocks are separated by commas and written as `start-end@temperature`, or `start-end@off` to turn heating off. Temperatures can be given to a tenth of a degree. They must be ordered and must not overlap. " +
+			"Gaps between the time blocks, before the first and after the last block are filled with blocks which turn heating off, so that the day is closed at 00:00. " +
+			"An end of '00:00' or '24:00' means the end of the day. An empty string turns heating off for the whole day.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "schedule",
+				MarkdownDescription: "Compact schedule of a day, e.g. `\"06:00-09:00@20.5, 17:00-22:00@21\"`.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: timeBlockObjectType,
+		},
+	}
+}
+
+func (*ScheduleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &schedule))
+	if resp.Error != nil {
+		return
+	}
+
+	blocks, err := parseCompactTimeBlocks(schedule)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse schedule: %v.", err))
+		return
+	}
+
+	setScheduleResult(ctx, resp, overlayTimeBlocks(offDay, blocks))
+}
+
+// offDay is a schedule which turns heating off for the whole day.
+var offDay = []normalizedTimeBlock{{start: 0, end: minutesPerDay, geofencingControl: true}}
+
+// parseCompactSchedule parses a compact schedule of a day, e.g.
+// '06:00-09:00@20.5, 17:00-22:00@21', and returns its time blocks. Gaps are
+// filled with blocks which turn heating off, so that the blocks cover the
+// whole day. Errors name the (1-based) character position of the problem.
+func parseCompactSchedule(schedule string) ([]TimeBlockModel, error) {
+	blocks, err := parseCompactTimeBlocks(schedule)
+	if err != nil {
+		return nil, err
+	}
+	return normalizedTimeBlocksToModels(overlayTimeBlocks(offDay, blocks)), nil
+}
+
+// parseCompactTimeBlocks parses the time blocks of a compact schedule without
+// filling the gaps between them.
+func parseCompactTimeBlocks(schedule string) ([]normalizedTimeBlock, error) {
+	blocks := []normalizedTimeBlock{}
+	if strings.TrimSpace(schedule) == "" {
+		return blocks, nil
+	}
+
+	previousEnd, offset := 0, 0
+	for _, item := range strings.Split(schedule, ",") {
+		// position is the 1-based position of the first character of the
+		// time block.
+		position := offset + len(item) - len(strings.TrimLeft(item, " \t\n")) + 1
+		offset += len(item) + 1
+		item = strings.TrimSpace(item)
+
+		dash, at := strings.Index(item, "-"), strings.Index(item, "@")
+		if dash < 0 || at < dash {
+			return nil, fmt.Errorf("expected a time block in the format 'hh:mm-hh:mm@temperature' at character %d, got '%s'", position, item)
+		}
+
+		start, err := parseTimeOfDay(item[:dash])
+		if err != nil {
+			return nil, fmt.Errorf("%v at character %d", err, position)
+		}
+		end, err := parseEndOfBlock(item[dash+1 : at])
+		if err != nil {
+			return nil, fmt.Errorf("%v at character %d", err, position+dash+1)
+		}
+
+		block := normalizedTimeBlock{start: start, end: end, geofencingControl: true}
+		if setting := item[at+1:]; setting != "off" {
+			temperature, err := strconv.ParseFloat(setting, 64)
+			if err != nil || math.IsNaN(temperature) || math.IsInf(temperature, 0) {
+				return nil, fmt.Errorf("invalid temperature '%s' at character %d, must be a number or 'off'", setting, position+at+1)
+			}
+			if tenths := temperature * 10; math.Abs(tenths-math.Round(tenths)) > 1e-6 {
+				return nil, fmt.Errorf("temperature '%s' at character %d is more precise than the tenth of a degree schedules support", setting, position+at+1)
+			}
+			block.heating, block.temperature = true, int64(math.Round(temperature*10))
+		}
+
+		switch {
+		case start < previousEnd:
+			return nil, fmt.Errorf("time block starting at %s at character %d overlaps with the previous block, which ends at %s", formatTimeOfDay(start), position, formatTimeOfDay(previousEnd))
+		case end <= start:
+			return nil, fmt.Errorf("time block at character %d must end after it starts at %s", position, formatTimeOfDay(start))
+		}
+
+		blocks = append(blocks, block)
+		previousEnd = end
+	}
+
+	return blocks, nil
+}
+
+// overlayTimeBlocks places time blocks on top of others, which they replace
+// where they overlap. Neither of them needs to cover the whole day. Adjacent
+// blocks with the same setting are merged.
+func overlayTimeBlocks(base, overlay []normalizedTimeBlock) []normalizedTimeBlock {
+	boundaries := []int{}
+	for _, block := range append(slices.Clone(base), overlay...) {
+		boundaries = append(boundaries, block.start, block.end)
+	}
+	slices.Sort(boundaries)
+	boundaries = slices.Compact(boundaries)
+
+	blocks := []normalizedTimeBlock{}
+	for i := 0; i+1 < len(boundaries); i++ {
+		start, end := boundaries[i], boundaries[i+1]
+		block, ok := timeBlockAt(overlay, start)
+		if !ok {
+			block, ok = timeBlockAt(base, start)
+		}
+		if !ok {
+			continue
+		}
+		if n := len(blocks); n > 0 && blocks[n-1].end == start && sameSetting(blocks[n-1], block) {
+			blocks[n-1].end = end
+			continue
+		}
+		block.start, block.end = start, end
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+// normalizedTimeBlocksToModels converts normalized time blocks back to time
+// block models.
+func normalizedTimeBlocksToModels(blocks []normalizedTimeBlock) []TimeBlockModel {
+	models := make([]TimeBlockModel, 0, len(blocks))
+	for _, block := range blocks {
+		model := TimeBlockModel{
+			Heating:           types.BoolValue(block.heating),
+			Temperature:       types.Float64Null(),
+			Start:             types.StringValue(formatTimeOfDay(block.start)),
+			End:               types.StringValue(formatTimeOfDay(block.end)),
+			GeofencingControl: types.BoolValue(block.geofencingControl),
+		}
+		if block.heating {
+			model.Temperature = types.Float64Value(float64(block.temperature) / 10)
+		}
+		models = append(models, model)
+	}
+	return models
+}
+
+// timeBlocksFromValue reads the time blocks of a day from a function
+// argument, which is either a list of time block objects or a compact
+// schedule. The time blocks are not validated.
+func timeBlocksFromValue(value types.Dynamic) ([]TimeBlockModel, error) {
+	var elements []attr.Value
+	switch value := value.UnderlyingValue().(type) {
+	case types.String:
+		return parseCompactSchedule(value.ValueString())
+	case types.List:
+		elements = value.Elements()
+	case types.Tuple:
+		elements = value.Elements()
+	default:
+		return nil, fmt.Errorf("expected a list of time blocks or a compact schedule")
+	}
+
+	blocks := make([]TimeBlockModel, 0, len(elements))
+	for i, element := range elements {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() {
+			return nil, fmt.Errorf("[%d]: expected a time block object", i)
+		}
+
+		block := TimeBlockModel{
+			Heating:           types.BoolNull(),
+			Temperature:       types.Float64Null(),
+			Start:             types.StringNull(),
+			End:               types.StringNull(),
+			GeofencingControl: types.BoolNull(),
+		}
+		for name, attribute := range object.Attributes() {
+			if attribute.IsNull() {
+				continue
+			}
+			if attribute.IsUnknown() {
+				return nil, fmt.Errorf("[%d].%s: value is not known", i, name)
+			}
+
+			ok := false
+			switch name {
+			case "heating":
+				block.Heating, ok = attribute.(types.Bool)
+			case "geofencing_control":
+				block.GeofencingControl, ok = attribute.(types.Bool)
+			case "start":
+				block.Start, ok = attribute.(types.String)
+			case "end":
+				block.End, ok = attribute.(types.String)
+			case "temperature":
+				switch temperature := attribute.(type) {
+				case types.Number:
+					value, _ := temperature.ValueBigFloat().Float64()
+					block.Temperature, ok = types.Float64Value(value), true
+				case types.Float64:
+					block.Temperature, ok = temperature, true
+				}
+			default:
+				return nil, fmt.Errorf("[%d].%s: unsupported attribute", i, name)
+			}
+			if !ok {
+				return nil, fmt.Errorf("[%d].%s: invalid type %s", i, name, attribute.Type(context.Background()))
+			}
+		}
+
+		for name, value := range map[string]attr.Value{"heating": block.Heating, "start": block.Start, "end": block.End} {
+			if value.IsNull() {
+				return nil, fmt.Errorf("[%d].%s: attribute is required", i, name)
+			}
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// timeBlockProblems validates the time blocks of a day like the day
+// attributes of tado_heating_schedule. Each problem is prefixed with the path
+// of the offending time block.
+func timeBlockProblems(blocks []TimeBlockModel) []string {
+	problems := []string{}
+	for _, d := range validateTimeBlocks(path.Empty(), blocks) {
+		problem := d.Detail()
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			problem = withPath.Path().String() + ": " + problem
+		}
+		problems = append(problems, problem)
+	}
+	return problems
+}
+
+// scheduleParameter returns a function parameter which takes the time blocks
+// of a day as a list or as a compact schedule.
+func scheduleParameter(name, description string) function.DynamicParameter {
+	return function.DynamicParameter{
+		Name:                name,
+		MarkdownDescription: description,
+	}
+}
+
+// scheduleArgument reads and validates the time blocks of a day from the
+// function argument at the given position.
+func scheduleArgument(value types.Dynamic, position int64) ([]normalizedTimeBlock, *function.FuncError) {
+	blocks, err := timeBlocksFromValue(value)
+	if err != nil {
+		return nil, function.NewArgumentFuncError(position, fmt.Sprintf("Invalid schedule: %v.", err))
+	}
+	if problems := timeBlockProblems(blocks); len(problems) > 0 {
+		return nil, function.NewArgumentFuncError(position, fmt.Sprintf("Invalid schedule: %s", strings.Join(problems, " ")))
+	}
+	normalized, _ := normalizeTimeBlockModels(blocks, gotado.TemperatureUnitCelsius)
+	return normalized, nil
+}
+
+// setScheduleResult sets the result of a function to a list of time blocks.
+func setScheduleResult(ctx context.Context, resp *function.RunResponse, blocks []normalizedTimeBlock) {
+	list, diags := types.ListValueFrom(ctx, timeBlockObjectType, normalizedTimeBlocksToModels(blocks))
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, list))
+}
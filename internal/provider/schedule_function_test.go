@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gonzolino/gotado/v2"
+)
+
+func TestParseCompactSchedule(t *testing.T) {
+	cases := map[string]struct {
+		schedule string
+		expected string
+		err      string
+	}{
+		"example": {
+			schedule: "06:00-09:00@20.5, 17:00-22:00@21",
+			expected: "00:00-06:00 off, 06:00-09:00 20.5, 09:00-17:00 off, 17:00-22:00 21, 22:00-00:00 off",
+		},
+		"whole day": {
+			schedule: "00:00-24:00@19",
+			expected: "00:00-00:00 19",
+		},
+		"empty": {
+			schedule: " ",
+			expected: "00:00-00:00 off",
+		},
+		"explicit off merged with gaps": {
+			schedule: "06:00-07:00@off,08:00-00:00@20",
+			expected: "00:00-08:00 off, 08:00-00:00 20",
+		},
+		"invalid start": {
+			schedule: "06:00-09:00@20.5, 25:00-26:00@21",
+			err:      "invalid time '25:00', format must be 'hh:mm' at character 19",
+		},
+		"invalid end": {
+			schedule: "06:00-9:00@20.5",
+			err:      "invalid time '9:00', format must be 'hh:mm' at character 7",
+		},
+		"invalid temperature": {
+			schedule: "06:00-09:00@warm",
+			err:      "invalid temperature 'warm' at character 13, must be a number or 'off'",
+		},
+		"temperature not a number": {
+			schedule: "06:00-09:00@NaN",
+			err:      "invalid temperature 'NaN' at character 13, must be a number or 'off'",
+		},
+		"infinite temperature": {
+			schedule: "06:00-09:00@20, 17:00-22:00@-Inf",
+			err:      "invalid temperature '-Inf' at character 29, must be a number or 'off'",
+		},
+		"temperature more precise than a tenth": {
+			schedule: "06:00-09:00@20, 17:00-22:00@20.55",
+			err:      "temperature '20.55' at character 29 is more precise than the tenth of a degree schedules support",
+		},
+		"missing temperature": {
+			schedule: "06:00-09:00@20, 17:00-22:00",
+			err:      "expected a time block in the format 'hh:mm-hh:mm@temperature' at character 17, got '17:00-22:00'",
+		},
+		"overlap": {
+			schedule: "06:00-09:00@20, 08:00-10:00@21",
+			err:      "time block starting at 08:00 at character 17 overlaps with the previous block, which ends at 09:00",
+		},
+		"ends before start": {
+			schedule: "09:00-06:00@20",
+			err:      "time block at character 1 must end after it starts at 09:00",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			blocks, err := parseCompactSchedule(tc.schedule)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("Expected: %s, got: %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			summary := make([]string, len(blocks))
+			for i, block := range blocks {
+				setting := "off"
+				if block.Heating.ValueBool() {
+					setting = fmt.Sprintf("%g", block.Temperature.ValueFloat64())
+				}
+				summary[i] = fmt.Sprintf("%s-%s %s", block.Start.ValueString(), block.End.ValueString(), setting)
+			}
+			if got := strings.Join(summary, ", "); got != tc.expected {
+				t.Fatalf("Expected: %s, got: %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestOverlayTimeBlocks(t *testing.T) {
+	base, err := parseCompactSchedule("06:00-09:00@20.5, 17:00-22:00@21")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	normalized, _ := normalizeTimeBlockModels(base, gotado.TemperatureUnitCelsius)
+
+	cases := map[string]struct {
+		overlay  string
+		expected string
+	}{
+		"empty": {
+			overlay:  "",
+			expected: "00:00-06:00 off, 06:00-09:00 20.5, 09:00-17:00 off, 17:00-22:00 21, 22:00-00:00 off",
+		},
+		"within a block": {
+			overlay:  "12:00-13:00@19",
+			expected: "00:00-06:00 off, 06:00-09:00 20.5, 09:00-12:00 off, 12:00-13:00 19, 13:00-17:00 off, 17:00-22:00 21, 22:00-00:00 off",
+		},
+		"across blocks": {
+			overlay:  "08:00-18:00@off",
+			expected: "00:00-06:00 off, 06:00-08:00 20.5, 08:00-18:00 off, 18:00-22:00 21, 22:00-00:00 off",
+		},
+		"same setting merged": {
+			overlay:  "09:00-17:00@21",
+			expected: "00:00-06:00 off, 06:00-09:00 20.5, 09:00-22:00 21, 22:00-00:00 off",
+		},
+		"whole day": {
+			overlay:  "00:00-00:00@18",
+			expected: "00:00-00:00 18",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			overlay, err := parseCompactTimeBlocks(tc.overlay)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			blocks := normalizedTimeBlocksToModels(overlayTimeBlocks(normalized, overlay))
+			summary := make([]string, len(blocks))
+			for i, block := range blocks {
+				setting := "off"
+				if block.Heating.ValueBool() {
+					setting = fmt.Sprintf("%g", block.Temperature.ValueFloat64())
+				}
+				summary[i] = fmt.Sprintf("%s-%s %s", block.Start.ValueString(), block.End.ValueString(), setting)
+			}
+			if got := strings.Join(summary, ", "); got != tc.expected {
+				t.Fatalf("Expected: %s, got: %s", tc.expected, got)
+			}
+		})
+	}
+}
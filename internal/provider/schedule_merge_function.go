@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ScheduleMergeFunction{}
+
+func NewScheduleMergeFunction() function.Function {
+	return &ScheduleMergeFunction{}
+}
+
+// ScheduleMergeFunction overlays time blocks onto a schedule.
+type ScheduleMergeFunction struct{}
+
+func (*ScheduleMergeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_merge"
+}
+
+func (*ScheduleMergeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Overlay time blocks onto a schedule",
+		MarkdownDescription: "Overlays time blocks onto the time blocks of a day. Other than the schedule, the overlay doesn't need to cover the whole day: where it has no time block, the schedule is kept.",
+		Parameters: []function.Parameter{
+			scheduleParameter("schedule", "Time blocks of a day, either as a list like the day attributes of `tado_heating_schedule` or as a compact schedule like the argument of `schedule`."),
+			scheduleParameter("overlay", "Time blocks which replace the schedule where they overlap with it, either as a list or as a compact schedule. The time blocks must be ordered and must not overlap, but may leave gaps."),
+		},
+		Return: function.ListReturn{
+			ElementType: timeBlockObjectType,
+		},
+	}
+}
+
+func (*ScheduleMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule, overlay types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &schedule, &overlay))
+	if resp.Error != nil {
+		return
+	}
+
+	blocks, funcErr := scheduleArgument(schedule, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	overlayBlocks, err := overlayArgument(overlay)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid overlay: %v.", err))
+		return
+	}
+
+	setScheduleResult(ctx, resp, overlayTimeBlocks(blocks, overlayBlocks))
+}
+
+// overlayArgument reads time blocks which don't need to cover the whole day.
+// Compact schedules are not filled with time blocks which turn heating off.
+func overlayArgument(value types.Dynamic) ([]normalizedTimeBlock, error) {
+	if compact, ok := value.UnderlyingValue().(types.String); ok {
+		return parseCompactTimeBlocks(compact.ValueString())
+	}
+
+	models, err := timeBlocksFromValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]normalizedTimeBlock, 0, len(models))
+	previousEnd := 0
+	for i, model := range models {
+		start, err := parseTimeOfDay(model.Start.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("[%d].start: %v", i, err)
+		}
+		end, err := parseEndOfBlock(model.End.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("[%d].end: %v", i, err)
+		}
+		switch {
+		case start < previousEnd:
+			return nil, fmt.Errorf("[%d].start: the time block overlaps with the previous block, which ends at %s", i, formatTimeOfDay(previousEnd))
+		case end <= start:
+			return nil, fmt.Errorf("[%d].end: the time block must end after it starts at %s", i, formatTimeOfDay(start))
+		case model.Heating.ValueBool() && model.Temperature.IsNull():
+			return nil, fmt.Errorf("[%d].temperature: the temperature is required when 'heating' is true", i)
+		}
+		previousEnd = end
+
+		block, _ := normalizeTimeBlockModels([]TimeBlockModel{model}, gotado.TemperatureUnitCelsius)
+		blocks = append(blocks, block...)
+	}
+	return blocks, nil
+}
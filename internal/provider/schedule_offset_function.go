@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ScheduleOffsetFunction{}
+
+func NewScheduleOffsetFunction() function.Function {
+	return &ScheduleOffsetFunction{}
+}
+
+// ScheduleOffsetFunction shifts all temperatures of a schedule.
+type ScheduleOffsetFunction struct{}
+
+func (*ScheduleOffsetFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_offset"
+}
+
+func (*ScheduleOffsetFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Shift all temperatures of a schedule",
+		MarkdownDescription: "Adds an offset to the temperature of every time block of a day which turns heating on. Time blocks which turn heating off are not changed.",
+		Parameters: []function.Parameter{
+			scheduleParameter("schedule", "Time blocks of a day, either as a list like the day attributes of `tado_heating_schedule` or as a compact schedule like the argument of `schedule`."),
+			function.Float64Parameter{
+				Name:                "offset",
+				MarkdownDescription: "Offset which is added to all temperatures. Negative values lower the temperatures.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: timeBlockObjectType,
+		},
+	}
+}
+
+func (*ScheduleOffsetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule types.Dynamic
+	var offset float64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &schedule, &offset))
+	if resp.Error != nil {
+		return
+	}
+
+	blocks, funcErr := scheduleArgument(schedule, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	for i := range blocks {
+		if blocks[i].heating {
+			blocks[i].temperature += int64(math.Round(offset * 10))
+		}
+	}
+
+	setScheduleResult(ctx, resp, blocks)
+}
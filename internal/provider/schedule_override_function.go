@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ScheduleOverrideFunction{}
+
+func NewScheduleOverrideFunction() function.Function {
+	return &ScheduleOverrideFunction{}
+}
+
+// ScheduleOverrideFunction replaces a time window of a schedule.
+type ScheduleOverrideFunction struct{}
+
+func (*ScheduleOverrideFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_override"
+}
+
+func (*ScheduleOverrideFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Replace a time window of a schedule",
+		MarkdownDescription: "Replaces the time window `start-end` of a day with a single time block. The time blocks which overlap with the window are cut at its start and end.",
+		Parameters: []function.Parameter{
+			scheduleParameter("schedule", "Time blocks of a day, either as a list like the day attributes of `tado_heating_schedule` or as a compact schedule like the argument of `schedule`."),
+			function.StringParameter{
+				Name:                "window",
+				MarkdownDescription: "Time window to replace in the format 'hh:mm-hh:mm', e.g. '12:00-14:00'. An end of '00:00' or '24:00' means the end of the day.",
+			},
+			function.Float64Parameter{
+				Name:                "temperature",
+				MarkdownDescription: "Temperature during the time window, or `null` to turn heating off.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: timeBlockObjectType,
+		},
+	}
+}
+
+func (*ScheduleOverrideFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule types.Dynamic
+	var window string
+	var temperature types.Float64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &schedule, &window, &temperature))
+	if resp.Error != nil {
+		return
+	}
+
+	blocks, funcErr := scheduleArgument(schedule, 0)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	start, end, err := parseTimeWindow(window)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid time window: %v.", err))
+		return
+	}
+
+	override := normalizedTimeBlock{start: start, end: end, geofencingControl: true}
+	if !temperature.IsNull() {
+		override.heating, override.temperature = true, int64(math.Round(temperature.ValueFloat64()*10))
+	}
+
+	setScheduleResult(ctx, resp, overlayTimeBlocks(blocks, []normalizedTimeBlock{override}))
+}
+
+// parseTimeWindow parses a time window in the format 'hh:mm-hh:mm' and
+// returns its start and end in minutes since midnight.
+func parseTimeWindow(window string) (int, int, error) {
+	startTime, endTime, ok := strings.Cut(window, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid time window '%s', format must be 'hh:mm-hh:mm'", window)
+	}
+	start, err := parseTimeOfDay(startTime)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseEndOfBlock(endTime)
+	if err != nil {
+		return 0, 0, err
+	}
+	if end <= start {
+		return 0, 0, fmt.Errorf("time window must end after it starts at %s", formatTimeOfDay(start))
+	}
+	return start, end, nil
+}
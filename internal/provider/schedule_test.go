@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected int
+		valid    bool
+	}{
+		{s: "00:00", expected: 0, valid: true},
+		{s: "06:30", expected: 390, valid: true},
+		{s: "23:59", expected: 1439, valid: true},
+		{s: "24:00", valid: false},
+		{s: "6:30", valid: false},
+		{s: "06:60", valid: false},
+		{s: "06-30", valid: false},
+		{s: "", valid: false},
+	}
+
+	for _, c := range cases {
+		actual, err := parseTimeOfDay(c.s)
+		if c.valid && err != nil {
+			t.Errorf("Expected '%s' to be valid, got: %v", c.s, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("Expected '%s' to be invalid, got no error", c.s)
+		}
+		if c.valid && actual != c.expected {
+			t.Errorf("Expected '%s' to be %d minutes, got: %d", c.s, c.expected, actual)
+		}
+	}
+}
+
+func TestParseEndOfBlock(t *testing.T) {
+	if actual, _ := parseEndOfBlock("00:00"); actual != minutesPerDay {
+		t.Errorf("Expected '00:00' to be %d minutes, got: %d", minutesPerDay, actual)
+	}
+	if actual, _ := parseEndOfBlock("24:00"); actual != minutesPerDay {
+		t.Errorf("Expected '24:00' to be %d minutes, got: %d", minutesPerDay, actual)
+	}
+	if actual, _ := parseEndOfBlock("06:00"); actual != 360 {
+		t.Errorf("Expected '06:00' to be 360 minutes, got: %d", actual)
+	}
+}
+
+func TestFormatTimeOfDay(t *testing.T) {
+	cases := []struct {
+		minutes  int
+		expected string
+	}{
+		{minutes: 0, expected: "00:00"},
+		{minutes: 390, expected: "06:30"},
+		{minutes: minutesPerDay, expected: "00:00"},
+	}
+
+	for _, c := range cases {
+		if actual := formatTimeOfDay(c.minutes); actual != c.expected {
+			t.Errorf("Expected: %s, got: %s", c.expected, actual)
+		}
+	}
+}
+
+func TestValidateTimeBlocks(t *testing.T) {
+	block := func(start, end string, heating bool, temperature types.Float64) TimeBlockModel {
+		return TimeBlockModel{
+			Heating:     types.BoolValue(heating),
+			Temperature: temperature,
+			Start:       types.StringValue(start),
+			End:         types.StringValue(end),
+		}
+	}
+	off := func(start, end string) TimeBlockModel { return block(start, end, false, types.Float64Null()) }
+	on := func(start, end string) TimeBlockModel { return block(start, end, true, types.Float64Value(20)) }
+	p := path.Root("mon")
+
+	cases := []struct {
+		name     string
+		blocks   []TimeBlockModel
+		expected []path.Path
+	}{
+		{
+			name:     "valid schedule",
+			blocks:   []TimeBlockModel{off("00:00", "06:00"), on("06:00", "22:00"), off("22:00", "00:00")},
+			expected: []path.Path{},
+		},
+		{
+			name:     "single block for the whole day",
+			blocks:   []TimeBlockModel{on("00:00", "00:00")},
+			expected: []path.Path{},
+		},
+		{
+			name:     "empty schedule",
+			blocks:   []TimeBlockModel{},
+			expected: []path.Path{p},
+		},
+		{
+			name:     "does not start at midnight",
+			blocks:   []TimeBlockModel{on("01:00", "00:00")},
+			expected: []path.Path{p.AtListIndex(0).AtName("start")},
+		},
+		{
+			name:     "does not end at midnight",
+			blocks:   []TimeBlockModel{off("00:00", "06:00"), on("06:00", "22:00")},
+			expected: []path.Path{p.AtListIndex(1).AtName("end")},
+		},
+		{
+			name:     "gap",
+			blocks:   []TimeBlockModel{off("00:00", "06:00"), on("07:00", "00:00")},
+			expected: []path.Path{p.AtListIndex(1).AtName("start")},
+		},
+		{
+			name:     "overlap",
+			blocks:   []TimeBlockModel{off("00:00", "06:00"), on("05:00", "00:00")},
+			expected: []path.Path{p.AtListIndex(1).AtName("start")},
+		},
+		{
+			name:     "ends before it starts",
+			blocks:   []TimeBlockModel{off("00:00", "06:00"), on("06:00", "05:00"), off("05:00", "00:00")},
+			expected: []path.Path{p.AtListIndex(1).AtName("end")},
+		},
+		{
+			name:     "invalid time format",
+			blocks:   []TimeBlockModel{off("00:00", "6:00"), on("06:00", "00:00")},
+			expected: []path.Path{p.AtListIndex(0).AtName("end")},
+		},
+		{
+			name:     "missing temperature",
+			blocks:   []TimeBlockModel{block("00:00", "00:00", true, types.Float64Null())},
+			expected: []path.Path{p.AtListIndex(0).AtName("temperature")},
+		},
+		{
+			name: "unknown values are skipped",
+			blocks: []TimeBlockModel{
+				off("00:00", "06:00"),
+				{Heating: types.BoolUnknown(), Temperature: types.Float64Unknown(), Start: types.StringUnknown(), End: types.StringUnknown()},
+				off("22:00", "00:00"),
+			},
+			expected: []path.Path{},
+		},
+	}
+
+	for _, c := range cases {
+		diags := validateTimeBlocks(p, c.blocks)
+		if len(diags) != len(c.expected) {
+			t.Errorf("%s: expected %d diagnostics, got: %v", c.name, len(c.expected), diags)
+			continue
+		}
+		for i, d := range diags {
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(c.expected[i]) {
+				t.Errorf("%s: expected diagnostic for %s, got: %v", c.name, c.expected[i], d)
+			}
+		}
+	}
+}
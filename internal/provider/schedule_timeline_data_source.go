@@ -0,0 +1,423 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ScheduleTimelineDataSource{}
+
+// defaultTimelineInterval is the default length of the slots of a timeline in
+// minutes.
+const defaultTimelineInterval = 15
+
+func NewScheduleTimelineDataSource() datasource.DataSource {
+	return &ScheduleTimelineDataSource{}
+}
+
+type ScheduleTimelineDataSource struct {
+	client *gotado.Tado
+}
+
+type ScheduleTimelineDataSourceModel struct {
+	ID              types.String              `tfsdk:"id"`
+	HomeID          types.Int64               `tfsdk:"home_id"`
+	HomeName        types.String              `tfsdk:"home_name"`
+	ZoneID          types.Int64               `tfsdk:"zone_id"`
+	ZoneName        types.String              `tfsdk:"zone_name"`
+	TemperatureUnit types.String              `tfsdk:"temperature_unit"`
+	MonSun          []TimeBlockModel          `tfsdk:"mon_sun"`
+	MonFri          []TimeBlockModel          `tfsdk:"mon_fri"`
+	Mon             []TimeBlockModel          `tfsdk:"mon"`
+	Tue             []TimeBlockModel          `tfsdk:"tue"`
+	Wed             []TimeBlockModel          `tfsdk:"wed"`
+	Thu             []TimeBlockModel          `tfsdk:"thu"`
+	Fri             []TimeBlockModel          `tfsdk:"fri"`
+	Sat             []TimeBlockModel          `tfsdk:"sat"`
+	Sun             []TimeBlockModel          `tfsdk:"sun"`
+	Days            *HeatingScheduleDaysModel `tfsdk:"days"`
+	Interval        types.Int64               `tfsdk:"interval"`
+	Slots           []TimelineSlotModel       `tfsdk:"slots"`
+	Timeouts        timeouts.Value            `tfsdk:"timeouts"`
+}
+
+// TimelineSlotModel is a slot of a schedule timeline.
+type TimelineSlotModel struct {
+	Day         types.String  `tfsdk:"day"`
+	Start       types.String  `tfsdk:"start"`
+	End         types.String  `tfsdk:"end"`
+	Power       types.String  `tfsdk:"power"`
+	Temperature types.Float64 `tfsdk:"temperature"`
+}
+
+// timeBlockInputAttributes are the attributes of time blocks which are passed
+// to a data source.
+var timeBlockInputAttributes = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"heating": schema.BoolAttribute{
+			MarkdownDescription: "Whether heating is turned on or off",
+			Required:            true,
+		},
+		"temperature": schema.Float64Attribute{
+			MarkdownDescription: "The temperature the heating is set to. Required when 'heating' is true",
+			Optional:            true,
+		},
+		"start": schema.StringAttribute{
+			MarkdownDescription: "When the timeblock starts. Format is 'hh:mm'.",
+			Required:            true,
+		},
+		"end": schema.StringAttribute{
+			MarkdownDescription: "When the timeblock ends. Format is 'hh:mm'.",
+			Required:            true,
+		},
+		"geofencing_control": schema.BoolAttribute{
+			MarkdownDescription: "Whether the settings of this time block are overwritten by the tado away settings. Defaults to 'true'.",
+			Optional:            true,
+		},
+	},
+}
+
+func (*ScheduleTimelineDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_timeline"
+}
+
+func (ScheduleTimelineDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	timeBlockList := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			NestedObject:        timeBlockInputAttributes,
+		}
+	}
+	daysTimeBlockList := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			MarkdownDescription: description,
+			Required:            true,
+			NestedObject:        timeBlockInputAttributes,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The setpoint of each slot of a week, e.g. every 15 minutes. The schedule is either the active heating schedule of a zone, or given as time blocks in the same format as the day attributes of the `tado_heating_schedule` resource. " +
+			"Schedules for groups of days ('mon_sun' and 'mon_fri') are expanded to the single days, so that every day of the week has its own slots.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of this timeline.",
+				Computed:            true,
+			},
+			"home_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the home the zone belongs to. Either `home_id` or `home_name` must be set to read the schedule of a zone.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"home_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the home the zone belongs to. Either `home_id` or `home_name` must be set to read the schedule of a zone.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone whose schedule is expanded. Either `zone_id`, `zone_name` or the time blocks of a schedule must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"zone_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the zone whose schedule is expanded. Either `zone_id`, `zone_name` or the time blocks of a schedule must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"temperature_unit": schema.StringAttribute{
+				MarkdownDescription: "Unit of the temperatures of the slots if the schedule of a zone is expanded. Either 'CELSIUS' or 'FAHRENHEIT'. Defaults to the temperature unit of the home. Temperatures of given time blocks are taken as they are.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(temperatureUnits...),
+				},
+			},
+			"mon_sun": timeBlockList("Schedule for Monday - Sunday."),
+			"mon_fri": timeBlockList("Schedule for Monday - Friday."),
+			"mon":     timeBlockList("Schedule for Monday."),
+			"tue":     timeBlockList("Schedule for Tuesday."),
+			"wed":     timeBlockList("Schedule for Wednesday."),
+			"thu":     timeBlockList("Schedule for Thursday."),
+			"fri":     timeBlockList("Schedule for Friday."),
+			"sat":     timeBlockList("Schedule for Saturday."),
+			"sun":     timeBlockList("Schedule for Sunday."),
+			"days": schema.SingleNestedAttribute{
+				MarkdownDescription: "Schedule for each day of the week, e.g. the `days` attribute of the `tado_heating_schedule` data source.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"mon": daysTimeBlockList("Schedule for Monday."),
+					"tue": daysTimeBlockList("Schedule for Tuesday."),
+					"wed": daysTimeBlockList("Schedule for Wednesday."),
+					"thu": daysTimeBlockList("Schedule for Thursday."),
+					"fri": daysTimeBlockList("Schedule for Friday."),
+					"sat": daysTimeBlockList("Schedule for Saturday."),
+					"sun": daysTimeBlockList("Schedule for Sunday."),
+				},
+			},
+			"interval": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Length of the slots in minutes. A day must be divisible into slots of this length. Defaults to %d.", defaultTimelineInterval),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, minutesPerDay),
+				},
+			},
+			"slots": schema.ListNestedAttribute{
+				MarkdownDescription: "Slots of the week, starting on Monday at 00:00. Each slot has the setting which is active when it starts.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day": schema.StringAttribute{
+							MarkdownDescription: "Day of the slot, from 'mon' to 'sun'.",
+							Computed:            true,
+						},
+						"start": schema.StringAttribute{
+							MarkdownDescription: "When the slot starts. Format is 'hh:mm'.",
+							Computed:            true,
+						},
+						"end": schema.StringAttribute{
+							MarkdownDescription: "When the slot ends. Format is 'hh:mm'. The last slot of a day ends at '00:00'.",
+							Computed:            true,
+						},
+						"power": schema.StringAttribute{
+							MarkdownDescription: "Whether heating is turned on ('ON') or off ('OFF').",
+							Computed:            true,
+						},
+						"temperature": schema.Float64Attribute{
+							MarkdownDescription: "The temperature the heating is set to. Null when 'power' is 'OFF'.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *ScheduleTimelineDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*tadoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *tadoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.newClient(ctx, &resp.Diagnostics)
+}
+
+func (d ScheduleTimelineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ScheduleTimelineDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	interval := int64(defaultTimelineInterval)
+	if !data.Interval.IsNull() {
+		interval = data.Interval.ValueInt64()
+	}
+	if minutesPerDay%interval != 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("interval"), "Invalid Interval", fmt.Sprintf("A day can't be divided into slots of %d minutes.", interval))
+		return
+	}
+
+	model := HeatingScheduleResourceModel{
+		MonSun: data.MonSun, MonFri: data.MonFri,
+		Mon: data.Mon, Tue: data.Tue, Wed: data.Wed, Thu: data.Thu, Fri: data.Fri, Sat: data.Sat, Sun: data.Sun,
+		Days: data.Days,
+	}
+	hasBlocks := heatingScheduleModelHasBlocks(model)
+	hasZone := !data.ZoneID.IsNull() || !data.ZoneName.IsNull()
+
+	var days *HeatingScheduleDaysModel
+	switch {
+	case hasBlocks && hasZone:
+		resp.Diagnostics.AddError("Invalid Schedule", "Either a zone or the time blocks of a schedule must be set, not both.")
+		return
+	case hasBlocks:
+		resp.Diagnostics.Append(validateTimelineTimeBlocks(model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		days = heatingScheduleModelToDaysModel(model)
+		data.ID = types.StringValue("schedule")
+		data.HomeID, data.HomeName, data.ZoneID, data.ZoneName = types.Int64Null(), types.StringNull(), types.Int64Null(), types.StringNull()
+	case hasZone:
+		if data.HomeID.IsNull() && data.HomeName.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("home_name"), "Missing Home", "Either 'home_id' or 'home_name' must be set to read the schedule of a zone.")
+			return
+		}
+
+		me, err := d.client.Me(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+			return
+		}
+
+		home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		zone, diags := getZone(ctx, home, data.ZoneID, data.ZoneName)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(checkName(path.Root("home_name"), "Home", data.HomeName, home.Name)...)
+		resp.Diagnostics.Append(checkName(path.Root("zone_name"), "Zone", data.ZoneName, zone.Name)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		schedule, err := zone.GetHeatingSchedule(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get heating schedule for zone '%s': %v", zone.Name, err))
+			return
+		}
+
+		days = heatingScheduleToDaysModel(ctx, schedule, temperatureUnit(data.TemperatureUnit, home))
+		data.ID = types.StringValue(fmt.Sprintf("%d/%d", home.ID, zone.ID))
+		data.HomeID = types.Int64Value(int64(home.ID))
+		data.HomeName = types.StringValue(home.Name)
+		data.ZoneID = types.Int64Value(int64(zone.ID))
+		data.ZoneName = types.StringValue(zone.Name)
+	default:
+		resp.Diagnostics.AddError("Missing Schedule", "Either a zone ('zone_id' or 'zone_name') or the time blocks of a schedule must be set.")
+		return
+	}
+
+	slots, ok := scheduleTimelineSlots(days, int(interval))
+	if !ok {
+		resp.Diagnostics.AddError("Invalid Schedule", "The schedule doesn't have valid time blocks for every day of the week.")
+		return
+	}
+
+	data.Interval = types.Int64Value(interval)
+	data.Slots = slots
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// heatingScheduleModelHasBlocks checks if any of the day attributes of a
+// model is set.
+func heatingScheduleModelHasBlocks(data HeatingScheduleResourceModel) bool {
+	return data.MonSun != nil || data.MonFri != nil || data.Mon != nil || data.Tue != nil || data.Wed != nil || data.Thu != nil || data.Fri != nil || data.Sat != nil || data.Sun != nil || data.Days != nil
+}
+
+// validateTimelineTimeBlocks checks that the given time blocks form one of the
+// timetables of the tado_heating_schedule resource, and validates the blocks
+// of each day like the resource does.
+func validateTimelineTimeBlocks(data HeatingScheduleResourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if data.Days == nil && !isMonSunSchedule(data) && !isMonFriSatSunSchedule(data) && !isMonTueWedThuFriSatSunSchedule(data) {
+		diags.AddError("Invalid Schedule", "Either 'mon_sun', 'mon_fri', 'sat' and 'sun', all days from 'mon' to 'sun', or 'days' must be set.")
+		return diags
+	}
+	if data.Days != nil && (data.MonSun != nil || data.MonFri != nil || data.Mon != nil || data.Tue != nil || data.Wed != nil || data.Thu != nil || data.Fri != nil || data.Sat != nil || data.Sun != nil) {
+		diags.AddAttributeError(path.Root("days"), "Invalid Schedule", "'days' can't be combined with the other day attributes.")
+		return diags
+	}
+
+	lists := map[string][]TimeBlockModel{
+		"mon_sun": data.MonSun, "mon_fri": data.MonFri,
+		"mon": data.Mon, "tue": data.Tue, "wed": data.Wed, "thu": data.Thu, "fri": data.Fri, "sat": data.Sat, "sun": data.Sun,
+	}
+	for _, name := range scheduleDayAttributes {
+		if blocks := lists[name]; blocks != nil {
+			diags.Append(validateTimeBlocks(path.Root(name), blocks)...)
+		}
+	}
+	if data.Days != nil {
+		for day, blocks := range daysModelBlocks(*data.Days) {
+			diags.Append(validateTimeBlocks(path.Root("days").AtName(scheduleDayNames[day]), blocks)...)
+		}
+	}
+	return diags
+}
+
+// scheduleDayNames are the names of the day attributes of each day of the
+// week, starting on Monday.
+var scheduleDayNames = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+// scheduleTimelineSlots divides each day of the week into slots of the given
+// number of minutes. Each slot gets the setting which is active when it starts.
+// The second return value is false if any of the days has no valid blocks.
+func scheduleTimelineSlots(days *HeatingScheduleDaysModel, interval int) ([]TimelineSlotModel, bool) {
+	if days == nil {
+		return nil, false
+	}
+
+	slots := make([]TimelineSlotModel, 0, minutesPerWeek/interval)
+	for day, dayBlocks := range daysModelBlocks(*days) {
+		blocks, ok := normalizeTimeBlockModels(dayBlocks, gotado.TemperatureUnitCelsius)
+		if !ok || len(blocks) == 0 {
+			return nil, false
+		}
+		for start := 0; start < minutesPerDay; start += interval {
+			block, ok := timeBlockAt(blocks, start)
+			if !ok {
+				return nil, false
+			}
+			slot := TimelineSlotModel{
+				Day:         types.StringValue(scheduleDayNames[day]),
+				Start:       types.StringValue(formatTimeOfDay(start)),
+				End:         types.StringValue(formatTimeOfDay(start + interval)),
+				Power:       types.StringValue(string(gotado.PowerOff)),
+				Temperature: types.Float64Null(),
+			}
+			if block.heating {
+				slot.Power = types.StringValue(string(gotado.PowerOn))
+				slot.Temperature = types.Float64Value(float64(block.temperature) / 10)
+			}
+			slots = append(slots, slot)
+		}
+	}
+	return slots, true
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestScheduleTimelineSlots(t *testing.T) {
+	block := func(start, end string, temperature float64) TimeBlockModel {
+		model := TimeBlockModel{
+			Heating:           types.BoolValue(temperature > 0),
+			Temperature:       types.Float64Null(),
+			Start:             types.StringValue(start),
+			End:               types.StringValue(end),
+			GeofencingControl: types.BoolNull(),
+		}
+		if temperature > 0 {
+			model.Temperature = types.Float64Value(temperature)
+		}
+		return model
+	}
+	weekday := []TimeBlockModel{block("00:00", "06:10", 0), block("06:10", "18:00", 20.5), block("18:00", "00:00", 0)}
+	weekend := []TimeBlockModel{block("00:00", "00:00", 18)}
+	days := &HeatingScheduleDaysModel{Mon: weekday, Tue: weekday, Wed: weekday, Thu: weekday, Fri: weekday, Sat: weekend, Sun: weekend}
+
+	cases := map[string]struct {
+		days     *HeatingScheduleDaysModel
+		interval int
+		expected string
+		ok       bool
+	}{
+		"six hours": {
+			days:     days,
+			interval: 360,
+			expected: "mon 00:00-06:00 OFF, mon 06:00-12:00 OFF, mon 12:00-18:00 ON 20.5, mon 18:00-00:00 OFF, " +
+				"tue 00:00-06:00 OFF, tue 06:00-12:00 OFF, tue 12:00-18:00 ON 20.5, tue 18:00-00:00 OFF, " +
+				"wed 00:00-06:00 OFF, wed 06:00-12:00 OFF, wed 12:00-18:00 ON 20.5, wed 18:00-00:00 OFF, " +
+				"thu 00:00-06:00 OFF, thu 06:00-12:00 OFF, thu 12:00-18:00 ON 20.5, thu 18:00-00:00 OFF, " +
+				"fri 00:00-06:00 OFF, fri 06:00-12:00 OFF, fri 12:00-18:00 ON 20.5, fri 18:00-00:00 OFF, " +
+				"sat 00:00-06:00 ON 18, sat 06:00-12:00 ON 18, sat 12:00-18:00 ON 18, sat 18:00-00:00 ON 18, " +
+				"sun 00:00-06:00 ON 18, sun 06:00-12:00 ON 18, sun 12:00-18:00 ON 18, sun 18:00-00:00 ON 18",
+			ok: true,
+		},
+		"missing day": {
+			days:     &HeatingScheduleDaysModel{Mon: weekday},
+			interval: 360,
+			ok:       false,
+		},
+		"unknown schedule": {
+			days:     nil,
+			interval: 15,
+			ok:       false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			slots, ok := scheduleTimelineSlots(tc.days, tc.interval)
+			if ok != tc.ok {
+				t.Fatalf("Expected: %t, got: %t", tc.ok, ok)
+			}
+			if !ok {
+				return
+			}
+
+			summary := make([]string, len(slots))
+			for i, slot := range slots {
+				summary[i] = fmt.Sprintf("%s %s-%s %s", slot.Day.ValueString(), slot.Start.ValueString(), slot.End.ValueString(), slot.Power.ValueString())
+				if !slot.Temperature.IsNull() {
+					summary[i] += fmt.Sprintf(" %g", slot.Temperature.ValueFloat64())
+				}
+			}
+			if got := strings.Join(summary, ", "); got != tc.expected {
+				t.Fatalf("Expected: %s, got: %s", tc.expected, got)
+			}
+		})
+	}
+}
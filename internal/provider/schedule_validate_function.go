@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ScheduleValidateFunction{}
+
+func NewScheduleValidateFunction() function.Function {
+	return &ScheduleValidateFunction{}
+}
+
+// ScheduleValidateFunction returns the problems of a schedule.
+type ScheduleValidateFunction struct{}
+
+func (*ScheduleValidateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_validate"
+}
+
+func (*ScheduleValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Return the problems of a schedule",
+		MarkdownDescription: "Validates the time blocks of a day like `tado_heating_schedule` does and returns the problems it finds. Each problem starts with the index of the offending time block, e.g. `[1].start: Gap between 06:00 and 07:00: the time block must start when the previous block ends.`. The list is empty if the schedule is valid. Temperatures are not checked against the capabilities of a zone.",
+		Parameters: []function.Parameter{
+			scheduleParameter("schedule", "Time blocks of a day, either as a list like the day attributes of `tado_heating_schedule` or as a compact schedule like the argument of `schedule`."),
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (*ScheduleValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var schedule types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &schedule))
+	if resp.Error != nil {
+		return
+	}
+
+	problems := []string{}
+	if blocks, err := timeBlocksFromValue(schedule); err != nil {
+		problems = append(problems, err.Error())
+	} else {
+		problems = timeBlockProblems(blocks)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, problems))
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"math"
+	"time"
+)
+
+// Zenith angles in degrees at which the sun rises or sets, and at which civil
+// twilight begins or ends.
+const (
+	sunriseZenith       = 90.833
+	civilTwilightZenith = 96.0
+)
+
+// sunEvent calculates when the sun passes the given zenith angle in the
+// morning (rising) or in the evening on a date, at the given coordinates. It
+// uses the general solar position calculations of the NOAA Global Monitoring
+// Division, which are accurate to about a minute outside of the polar
+// regions. The second return value is false if the sun doesn't pass the
+// zenith angle on that day, e.g. during polar day or night.
+func sunEvent(date time.Time, latitude, longitude, zenith float64, rising bool) (time.Time, bool) {
+	midnight := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Fractional year in radians. It is taken at noon of the date, where the
+	// (hour-12)/24 term of the NOAA formula is zero. Leap years have 366 days.
+	daysInYear := time.Date(date.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	gamma := 2 * math.Pi / float64(daysInYear) * float64(date.YearDay()-1)
+
+	// Equation of time in minutes and solar declination in radians.
+	eqTime := 229.18 * (0.000075 + 0.001868*math.Cos(gamma) - 0.032077*math.Sin(gamma) -
+		0.014615*math.Cos(2*gamma) - 0.040849*math.Sin(2*gamma))
+	declination := 0.006918 - 0.399912*math.Cos(gamma) + 0.070257*math.Sin(gamma) -
+		0.006758*math.Cos(2*gamma) + 0.000907*math.Sin(2*gamma) -
+		0.002697*math.Cos(3*gamma) + 0.00148*math.Sin(3*gamma)
+
+	lat := latitude * math.Pi / 180
+	cosHourAngle := math.Cos(zenith*math.Pi/180)/(math.Cos(lat)*math.Cos(declination)) - math.Tan(lat)*math.Tan(declination)
+	if cosHourAngle < -1 || cosHourAngle > 1 {
+		return time.Time{}, false
+	}
+	hourAngle := math.Acos(cosHourAngle) * 180 / math.Pi
+	if !rising {
+		hourAngle = -hourAngle
+	}
+
+	minutes := 720 - 4*(longitude+hourAngle) - eqTime
+	return midnight.Add(time.Duration(minutes * float64(time.Minute))), true
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSunEvent(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	cases := map[string]struct {
+		date                time.Time
+		latitude, longitude float64
+		zenith              float64
+		rising              bool
+		location            *time.Location
+		expected            string
+	}{
+		"sunrise in berlin at midsummer": {
+			date: time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), latitude: 52.52, longitude: 13.405,
+			zenith: sunriseZenith, rising: true, location: berlin, expected: "04:43",
+		},
+		"sunset in berlin at midsummer": {
+			date: time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), latitude: 52.52, longitude: 13.405,
+			zenith: sunriseZenith, rising: false, location: berlin, expected: "21:33",
+		},
+		"civil dusk in berlin at midwinter": {
+			date: time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC), latitude: 52.52, longitude: 13.405,
+			zenith: civilTwilightZenith, rising: false, location: berlin, expected: "16:36",
+		},
+		"sunrise in berlin on the last day of a leap year": {
+			date: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), latitude: 52.52, longitude: 13.405,
+			zenith: sunriseZenith, rising: true, location: berlin, expected: "08:17",
+		},
+		"sunrise in new york": {
+			date: time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), latitude: 40.7128, longitude: -74.006,
+			zenith: sunriseZenith, rising: true, location: newYork, expected: "07:00",
+		},
+		"polar night": {
+			date: time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC), latitude: 78.22, longitude: 15.65,
+			zenith: sunriseZenith, rising: true, location: time.UTC, expected: "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			event, ok := sunEvent(tc.date, tc.latitude, tc.longitude, tc.zenith, tc.rising)
+			if tc.expected == "" {
+				if ok {
+					t.Fatalf("Expected: no event, got: %v", event)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("Expected: %s, got: no event", tc.expected)
+			}
+
+			expected, _ := time.ParseInLocation("2006-01-02 15:04", tc.date.Format("2006-01-02 ")+tc.expected, tc.location)
+			if diff := event.Sub(expected); diff < -2*time.Minute || diff > 2*time.Minute {
+				t.Fatalf("Expected: %s, got: %s", tc.expected, event.In(tc.location).Format("15:04"))
+			}
+		})
+	}
+}
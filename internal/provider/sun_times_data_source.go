@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &SunTimesDataSource{}
+
+// dateLayout is the format of dates, e.g. '2024-06-21'.
+const dateLayout = "2006-01-02"
+
+func NewSunTimesDataSource() datasource.DataSource {
+	return &SunTimesDataSource{}
+}
+
+type SunTimesDataSource struct {
+	client *gotado.Tado
+}
+
+type SunTimesDataSourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	HomeID          types.Int64    `tfsdk:"home_id"`
+	HomeName        types.String   `tfsdk:"home_name"`
+	GeolocationLat  types.Float64  `tfsdk:"geolocation_lat"`
+	GeolocationLong types.Float64  `tfsdk:"geolocation_long"`
+	TimeZone        types.String   `tfsdk:"time_zone"`
+	Date            types.String   `tfsdk:"date"`
+	CivilDawn       types.String   `tfsdk:"civil_dawn"`
+	Sunrise         types.String   `tfsdk:"sunrise"`
+	Sunset          types.String   `tfsdk:"sunset"`
+	CivilDusk       types.String   `tfsdk:"civil_dusk"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (*SunTimesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sun_times"
+}
+
+func (SunTimesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sunrise, sunset and civil twilight at the location of a tado home on a date. The times are calculated offline and formatted as 'hh:mm' in the time zone of the home, so that they can be used as the start or end of time blocks. " +
+			"The location is either read from a home, or given by `geolocation_lat` and `geolocation_long`, in which case tado is not queried at all.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of these sun times.",
+				Computed:            true,
+			},
+			"home_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the home. Either `home_id`, `home_name` or `geolocation_lat` and `geolocation_long` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"home_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the home. Either `home_id`, `home_name` or `geolocation_lat` and `geolocation_long` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("home_id"), path.MatchRoot("geolocation_lat")),
+				},
+			},
+			"geolocation_lat": schema.Float64Attribute{
+				MarkdownDescription: "Latitude of the location. Defaults to the latitude of the home.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(-90, 90),
+					float64validator.AlsoRequires(path.MatchRoot("geolocation_long")),
+					float64validator.ConflictsWith(path.MatchRoot("home_id"), path.MatchRoot("home_name")),
+				},
+			},
+			"geolocation_long": schema.Float64Attribute{
+				MarkdownDescription: "Longitude of the location, positive to the east. Defaults to the longitude of the home.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(-180, 180),
+					float64validator.AlsoRequires(path.MatchRoot("geolocation_lat")),
+				},
+			},
+			"time_zone": schema.StringAttribute{
+				MarkdownDescription: "Time zone of the times, e.g. 'Europe/Berlin'. Defaults to the time zone of the home, or to 'UTC' if the location is given by coordinates.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"date": schema.StringAttribute{
+				MarkdownDescription: "Date in the format 'yyyy-mm-dd'. Defaults to today in `time_zone`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"civil_dawn": schema.StringAttribute{
+				MarkdownDescription: "Begin of civil twilight in the morning, when the sun is 6° below the horizon. Format is 'hh:mm'. Null if the sun doesn't reach this position on the date.",
+				Computed:            true,
+			},
+			"sunrise": schema.StringAttribute{
+				MarkdownDescription: "Sunrise. Format is 'hh:mm'. Null if the sun doesn't rise on the date.",
+				Computed:            true,
+			},
+			"sunset": schema.StringAttribute{
+				MarkdownDescription: "Sunset. Format is 'hh:mm'. Null if the sun doesn't set on the date.",
+				Computed:            true,
+			},
+			"civil_dusk": schema.StringAttribute{
+				MarkdownDescription: "End of civil twilight in the evening, when the sun is 6° below the horizon. Format is 'hh:mm'. Null if the sun doesn't reach this position on the date.",
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *SunTimesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*tadoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *tadoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.newClient(ctx, &resp.Diagnostics)
+}
+
+func (d SunTimesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SunTimesDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	timeZone := "UTC"
+	if data.GeolocationLat.IsNull() {
+		me, err := d.client.Me(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+			return
+		}
+
+		home, diags := getHome(ctx, me, data.HomeID, data.HomeName)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(checkName(path.Root("home_name"), "Home", data.HomeName, home.Name)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.HomeID = types.Int64Value(int64(home.ID))
+		data.HomeName = types.StringValue(home.Name)
+		data.GeolocationLat = types.Float64Value(home.Geolocation.Latitude)
+		data.GeolocationLong = types.Float64Value(home.Geolocation.Longitude)
+		if home.DateTimeZone != "" {
+			timeZone = home.DateTimeZone
+		}
+	} else {
+		data.HomeID = types.Int64Null()
+		data.HomeName = types.StringNull()
+	}
+
+	if !data.TimeZone.IsNull() {
+		timeZone = data.TimeZone.ValueString()
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("time_zone"), "Invalid Time Zone", fmt.Sprintf("Unknown time zone '%s': %v", timeZone, err))
+		return
+	}
+
+	date := time.Now().In(location)
+	if !data.Date.IsNull() {
+		date, err = time.Parse(dateLayout, data.Date.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("date"), "Invalid Date", fmt.Sprintf("Unable to parse date '%s', format must be 'yyyy-mm-dd'.", data.Date.ValueString()))
+			return
+		}
+	}
+
+	sunTime := func(zenith float64, rising bool) types.String {
+		t, ok := sunEvent(date, data.GeolocationLat.ValueFloat64(), data.GeolocationLong.ValueFloat64(), zenith, rising)
+		if !ok {
+			return types.StringNull()
+		}
+		return types.StringValue(t.In(location).Round(time.Minute).Format("15:04"))
+	}
+
+	data.TimeZone = types.StringValue(timeZone)
+	data.Date = types.StringValue(date.Format(dateLayout))
+	data.ID = types.StringValue(fmt.Sprintf("%g,%g:%s", data.GeolocationLat.ValueFloat64(), data.GeolocationLong.ValueFloat64(), data.Date.ValueString()))
+	data.CivilDawn = sunTime(civilTwilightZenith, true)
+	data.Sunrise = sunTime(sunriseZenith, true)
+	data.Sunset = sunTime(sunriseZenith, false)
+	data.CivilDusk = sunTime(civilTwilightZenith, false)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
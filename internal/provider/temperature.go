@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Steps in which tado sets temperatures in each unit. Converted temperatures
+// and temperatures of settings are rounded to these steps.
+const (
+	celsiusStep    = 0.1
+	fahrenheitStep = 1.0
+)
+
+// temperatureUnits are the valid values of temperature_unit attributes.
+var temperatureUnits = []string{string(gotado.TemperatureUnitCelsius), string(gotado.TemperatureUnitFahrenheit)}
+
+// temperatureUnit returns the configured temperature unit, or the temperature
+// unit of the home if none is configured.
+func temperatureUnit(value types.String, home *gotado.Home) gotado.TemperatureUnit {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return home.TemperatureUnit
+	}
+	return gotado.TemperatureUnit(value.ValueString())
+}
+
+// roundTemperature rounds a temperature to the step of its unit.
+func roundTemperature(temperature float64, unit gotado.TemperatureUnit) float64 {
+	perDegree := math.Round(1 / celsiusStep)
+	if unit == gotado.TemperatureUnitFahrenheit {
+		perDegree = math.Round(1 / fahrenheitStep)
+	}
+	return math.Round(temperature*perDegree) / perDegree
+}
+
+// convertTemperature converts a temperature from one unit to another. The
+// converted temperature is rounded to the step of the target unit, so that
+// tado accepts it.
+func convertTemperature(temperature float64, from, to gotado.TemperatureUnit) float64 {
+	switch {
+	case from == to:
+		return temperature
+	case to == gotado.TemperatureUnitFahrenheit:
+		return roundTemperature(temperature*9/5+32, to)
+	default:
+		return roundTemperature((temperature-32)*5/9, to)
+	}
+}
+
+// checkTemperatureConversion checks that a temperature is read back unchanged
+// after it has been converted to the unit of the home.
+func checkTemperatureConversion(temperature float64, unit, homeUnit gotado.TemperatureUnit) error {
+	converted := convertTemperature(temperature, unit, homeUnit)
+	if back := convertTemperature(converted, homeUnit, unit); math.Abs(back-temperature) > 1e-6 {
+		return fmt.Errorf("temperature %g is set to %g in the unit of the home, which is read back as %g", temperature, converted, back)
+	}
+	return nil
+}
+
+// temperatureInUnit picks the value of a temperature reported by tado in both
+// units.
+func temperatureInUnit(celsius, fahrenheit float64, unit gotado.TemperatureUnit) float64 {
+	if unit == gotado.TemperatureUnitFahrenheit {
+		return fahrenheit
+	}
+	return celsius
+}
+
+// settingTemperature returns the temperature of a zone setting in the given
+// unit, rounded to the step of the unit.
+func settingTemperature(temperature *gotado.ZoneSettingTemperature, unit gotado.TemperatureUnit) float64 {
+	return roundTemperature(temperatureInUnit(temperature.Celsius, temperature.Fahrenheit, unit), unit)
+}
+
+// temperatureCapabilities returns the temperature capabilities of a zone in
+// the given unit. It returns nil if the zone doesn't report any.
+func temperatureCapabilities(capabilities *gotado.ZoneCapabilities, unit gotado.TemperatureUnit) *gotado.ZoneCapabilitiesTemperatureValues {
+	if capabilities.Temperatures == nil {
+		return nil
+	}
+	if unit == gotado.TemperatureUnitFahrenheit {
+		return capabilities.Temperatures.Fahrenheit
+	}
+	return capabilities.Temperatures.Celsius
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConvertTemperature(t *testing.T) {
+	cases := map[string]struct {
+		temperature float64
+		from, to    gotado.TemperatureUnit
+		expected    float64
+	}{
+		"same unit": {
+			temperature: 20.55,
+			from:        gotado.TemperatureUnitCelsius,
+			to:          gotado.TemperatureUnitCelsius,
+			expected:    20.55,
+		},
+		"celsius to fahrenheit": {
+			temperature: 20,
+			from:        gotado.TemperatureUnitCelsius,
+			to:          gotado.TemperatureUnitFahrenheit,
+			expected:    68,
+		},
+		"celsius to fahrenheit rounded": {
+			temperature: 20.5,
+			from:        gotado.TemperatureUnitCelsius,
+			to:          gotado.TemperatureUnitFahrenheit,
+			expected:    69,
+		},
+		"fahrenheit to celsius": {
+			temperature: 68,
+			from:        gotado.TemperatureUnitFahrenheit,
+			to:          gotado.TemperatureUnitCelsius,
+			expected:    20,
+		},
+		"fahrenheit to celsius rounded": {
+			temperature: 69,
+			from:        gotado.TemperatureUnitFahrenheit,
+			to:          gotado.TemperatureUnitCelsius,
+			expected:    20.6,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if actual := convertTemperature(tc.temperature, tc.from, tc.to); actual != tc.expected {
+				t.Fatalf("Expected: %v, got: %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTemperatureUnit(t *testing.T) {
+	home := &gotado.Home{TemperatureUnit: gotado.TemperatureUnitFahrenheit}
+
+	if unit := temperatureUnit(types.StringNull(), home); unit != gotado.TemperatureUnitFahrenheit {
+		t.Errorf("Expected: unit of the home, got: %s", unit)
+	}
+	if unit := temperatureUnit(types.StringValue(string(gotado.TemperatureUnitCelsius)), home); unit != gotado.TemperatureUnitCelsius {
+		t.Errorf("Expected: configured unit, got: %s", unit)
+	}
+}
+
+func TestSettingTemperature(t *testing.T) {
+	temperature := &gotado.ZoneSettingTemperature{Celsius: 20.56, Fahrenheit: 69.01}
+
+	if actual := settingTemperature(temperature, gotado.TemperatureUnitCelsius); actual != 20.6 {
+		t.Errorf("Expected: 20.6, got: %v", actual)
+	}
+	if actual := settingTemperature(temperature, gotado.TemperatureUnitFahrenheit); actual != 69 {
+		t.Errorf("Expected: 69, got: %v", actual)
+	}
+}
+
+func TestCheckTemperatureConversion(t *testing.T) {
+	cases := map[string]struct {
+		temperature    float64
+		unit, homeUnit gotado.TemperatureUnit
+		valid          bool
+	}{
+		"same unit":             {20.5, gotado.TemperatureUnitCelsius, gotado.TemperatureUnitCelsius, true},
+		"fahrenheit in celsius": {69, gotado.TemperatureUnitFahrenheit, gotado.TemperatureUnitCelsius, true},
+		"celsius in fahrenheit": {20.6, gotado.TemperatureUnitCelsius, gotado.TemperatureUnitFahrenheit, true},
+		"rounded in fahrenheit": {20.5, gotado.TemperatureUnitCelsius, gotado.TemperatureUnitFahrenheit, false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := checkTemperatureConversion(tc.temperature, tc.unit, tc.homeUnit)
+			if (err == nil) != tc.valid {
+				t.Fatalf("Expected valid: %t, got: %v", tc.valid, err)
+			}
+		})
+	}
+}
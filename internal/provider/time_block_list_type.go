@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the time block list type fully satisfies framework interfaces
+var _ basetypes.ListTypable = timeBlockListType{}
+var _ basetypes.ListValuableWithSemanticEquals = timeBlockListValue{}
+
+// timeBlockObjectType is the object type of a single time block of a managed
+// schedule.
+var timeBlockObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"heating":            types.BoolType,
+		"temperature":        types.Float64Type,
+		"start":              types.StringType,
+		"end":                types.StringType,
+		"geofencing_control": types.BoolType,
+	},
+}
+
+// timeBlockListType is the type of the time block lists of a managed schedule.
+// Its values are semantically equal if tado treats them as the same schedule,
+// so that the way tado stores a schedule doesn't cause a diff.
+type timeBlockListType struct {
+	basetypes.ListType
+}
+
+func newTimeBlockListType() timeBlockListType {
+	return timeBlockListType{ListType: basetypes.ListType{ElemType: timeBlockObjectType}}
+}
+
+func (t timeBlockListType) Equal(o attr.Type) bool {
+	other, ok := o.(timeBlockListType)
+	if !ok {
+		return false
+	}
+	return t.ListType.Equal(other.ListType)
+}
+
+func (t timeBlockListType) String() string {
+	return "timeBlockListType"
+}
+
+func (t timeBlockListType) ValueFromList(_ context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return timeBlockListValue{ListValue: in}, nil
+}
+
+func (t timeBlockListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.ListType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	listValue, ok := value.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", value)
+	}
+	return timeBlockListValue{ListValue: listValue}, nil
+}
+
+func (t timeBlockListType) ValueType(_ context.Context) attr.Value {
+	return timeBlockListValue{}
+}
+
+// timeBlockListValue is a value of timeBlockListType.
+type timeBlockListValue struct {
+	basetypes.ListValue
+}
+
+func (v timeBlockListValue) Type(_ context.Context) attr.Type {
+	return newTimeBlockListType()
+}
+
+func (v timeBlockListValue) Equal(o attr.Value) bool {
+	other, ok := o.(timeBlockListValue)
+	if !ok {
+		return false
+	}
+	return v.ListValue.Equal(other.ListValue)
+}
+
+// ListSemanticEquals checks if two time block lists result in the same
+// schedule in tado. Lists with unknown values are never semantically equal.
+func (v timeBlockListValue) ListSemanticEquals(ctx context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	newValue, d := newValuable.ToListValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	var oldBlocks, newBlocks []TimeBlockModel
+	diags.Append(v.ListValue.ElementsAs(ctx, &oldBlocks, false)...)
+	diags.Append(newValue.ElementsAs(ctx, &newBlocks, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	// The temperature unit of the lists isn't known here, so temperatures are
+	// compared in the finer Celsius step. Resources keep temperatures which
+	// tado rounds to the step of a coarser unit themselves.
+	oldNormalized, ok := normalizeTimeBlockModels(oldBlocks, gotado.TemperatureUnitCelsius)
+	if !ok {
+		return false, diags
+	}
+	newNormalized, ok := normalizeTimeBlockModels(newBlocks, gotado.TemperatureUnitCelsius)
+	if !ok {
+		return false, diags
+	}
+
+	return slices.Equal(oldNormalized, newNormalized), diags
+}
+
+// normalizedTimeBlock is a time block in the form tado stores it.
+type normalizedTimeBlock struct {
+	start, end        int
+	heating           bool
+	temperature       int64
+	geofencingControl bool
+}
+
+// normalizeTimeBlockModels converts time blocks to the form tado stores them
+// in: times are parsed, temperatures are rounded to the step of the unit and
+// ignored if heating is off, geofencing_control defaults to true and adjacent
+// blocks with the same setting are merged. Temperatures are kept in tenths of
+// a degree. The second return value is false if any of the blocks is unknown
+// or invalid.
+func normalizeTimeBlockModels(blocks []TimeBlockModel, unit gotado.TemperatureUnit) ([]normalizedTimeBlock, bool) {
+	normalized := make([]normalizedTimeBlock, 0, len(blocks))
+	for _, block := range blocks {
+		if block.Heating.IsUnknown() || block.Temperature.IsUnknown() || block.Start.IsUnknown() || block.End.IsUnknown() || block.GeofencingControl.IsUnknown() {
+			return nil, false
+		}
+		start, err := parseTimeOfDay(block.Start.ValueString())
+		if err != nil {
+			return nil, false
+		}
+		end, err := parseEndOfBlock(block.End.ValueString())
+		if err != nil {
+			return nil, false
+		}
+
+		current := normalizedTimeBlock{
+			start:             start,
+			end:               end,
+			heating:           block.Heating.ValueBool(),
+			geofencingControl: geofencingControl(block),
+		}
+		if current.heating {
+			current.temperature = int64(math.Round(roundTemperature(block.Temperature.ValueFloat64(), unit) * 10))
+		}
+
+		if n := len(normalized); n > 0 {
+			previous := &normalized[n-1]
+			if previous.end == current.start && previous.heating == current.heating && previous.temperature == current.temperature && previous.geofencingControl == current.geofencingControl {
+				previous.end = current.end
+				continue
+			}
+		}
+		normalized = append(normalized, current)
+	}
+	return normalized, true
+}
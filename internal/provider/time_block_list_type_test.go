@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimeBlockListSemanticEquals(t *testing.T) {
+	block := func(start, end string, heating bool, temperature float64, geofencingControl types.Bool) TimeBlockModel {
+		model := TimeBlockModel{
+			Heating:           types.BoolValue(heating),
+			Temperature:       types.Float64Null(),
+			Start:             types.StringValue(start),
+			End:               types.StringValue(end),
+			GeofencingControl: geofencingControl,
+		}
+		if heating {
+			model.Temperature = types.Float64Value(temperature)
+		}
+		return model
+	}
+	list := func(blocks ...TimeBlockModel) timeBlockListValue {
+		value, diags := types.ListValueFrom(context.Background(), timeBlockObjectType, blocks)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		return timeBlockListValue{ListValue: value}
+	}
+
+	schedule := list(
+		block("00:00", "06:00", false, 0, types.BoolNull()),
+		block("06:00", "00:00", true, 20.5, types.BoolNull()),
+	)
+
+	cases := map[string]struct {
+		other    timeBlockListValue
+		expected bool
+	}{
+		"identical": {
+			other:    schedule,
+			expected: true,
+		},
+		"merged adjacent blocks": {
+			other: list(
+				block("00:00", "03:00", false, 0, types.BoolNull()),
+				block("03:00", "06:00", false, 0, types.BoolNull()),
+				block("06:00", "00:00", true, 20.5, types.BoolNull()),
+			),
+			expected: true,
+		},
+		"end of day as 24:00": {
+			other: list(
+				block("00:00", "06:00", false, 0, types.BoolNull()),
+				block("06:00", "24:00", true, 20.5, types.BoolNull()),
+			),
+			expected: true,
+		},
+		"explicit geofencing default": {
+			other: list(
+				block("00:00", "06:00", false, 0, types.BoolValue(true)),
+				block("06:00", "00:00", true, 20.5, types.BoolValue(true)),
+			),
+			expected: true,
+		},
+		"rounded temperature": {
+			other: list(
+				block("00:00", "06:00", false, 0, types.BoolNull()),
+				block("06:00", "00:00", true, 20.501, types.BoolNull()),
+			),
+			expected: true,
+		},
+		"different temperature": {
+			other: list(
+				block("00:00", "06:00", false, 0, types.BoolNull()),
+				block("06:00", "00:00", true, 21, types.BoolNull()),
+			),
+			expected: false,
+		},
+		"geofencing disabled": {
+			other: list(
+				block("00:00", "06:00", false, 0, types.BoolNull()),
+				block("06:00", "00:00", true, 20.5, types.BoolValue(false)),
+			),
+			expected: false,
+		},
+		"unknown value": {
+			other: list(
+				block("00:00", "06:00", false, 0, types.BoolNull()),
+				block("06:00", "00:00", true, 20.5, types.BoolUnknown()),
+			),
+			expected: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := schedule.ListSemanticEquals(context.Background(), tc.other)
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			if equal != tc.expected {
+				t.Fatalf("Expected: %t, got: %t", tc.expected, equal)
+			}
+		})
+	}
+}
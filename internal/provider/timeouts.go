@@ -0,0 +1,16 @@
+package provider
+
+import "time"
+
+// Default timeouts of operations of resources and data sources, unless
+// configured otherwise in their timeouts block.
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+
+	// defaultRequestTimeout is the default timeout of a single request to the
+	// tado API.
+	defaultRequestTimeout = 30 * time.Second
+)
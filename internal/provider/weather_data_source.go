@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &WeatherDataSource{}
+
+func NewWeatherDataSource() datasource.DataSource {
+	return &WeatherDataSource{}
+}
+
+type WeatherDataSource struct {
+	client *gotado.Tado
+}
+
+type WeatherDataSourceModel struct {
+	ID                 types.Int64    `tfsdk:"id"`
+	Home               types.String   `tfsdk:"home"`
+	TemperatureUnit    types.String   `tfsdk:"temperature_unit"`
+	OutsideTemperature types.Float64  `tfsdk:"outside_temperature"`
+	SolarIntensity     types.Float64  `tfsdk:"solar_intensity"`
+	WeatherState       types.String   `tfsdk:"weather_state"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (*WeatherDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_weather"
+}
+
+func (WeatherDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The current weather at the location of a tado home, as reported by tado.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Home ID. Either `id` or `home` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"home": schema.StringAttribute{
+				MarkdownDescription: "Name of the home. Either `id` or `home` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("id")),
+				},
+			},
+			"temperature_unit": schema.StringAttribute{
+				MarkdownDescription: "Unit of `outside_temperature`. Either 'CELSIUS' or 'FAHRENHEIT'. Defaults to the temperature unit of the home.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(temperatureUnits...),
+				},
+			},
+			"outside_temperature": schema.Float64Attribute{
+				MarkdownDescription: "Temperature outside the home, in the unit given by `temperature_unit`.",
+				Computed:            true,
+			},
+			"solar_intensity": schema.Float64Attribute{
+				MarkdownDescription: "Solar intensity at the location of the home in percent.",
+				Computed:            true,
+			},
+			"weather_state": schema.StringAttribute{
+				MarkdownDescription: "Current weather condition, e.g. 'SUN', 'CLOUDY' or 'RAIN'.",
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *WeatherDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*tadoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *tadoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.newClient(ctx, &resp.Diagnostics)
+}
+
+func (d WeatherDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WeatherDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	me, err := d.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, data.ID, data.Home)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkName(path.Root("home"), "Home", data.Home, home.Name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	weather, err := home.GetWeather(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get weather for home '%s': %v", home.Name, err))
+		return
+	}
+
+	data.ID = types.Int64Value(int64(home.ID))
+	data.Home = types.StringValue(home.Name)
+	unit := temperatureUnit(data.TemperatureUnit, home)
+	data.TemperatureUnit = types.StringValue(string(unit))
+	weatherToDataSourceModel(weather, unit, &data)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// weatherToDataSourceModel copies the weather at a home into the data source
+// model, with the outside temperature in the given unit. Values which are not
+// reported by tado are set to Null.
+func weatherToDataSourceModel(weather *gotado.Weather, unit gotado.TemperatureUnit, data *WeatherDataSourceModel) {
+	data.OutsideTemperature = types.Float64Null()
+	if weather.OutsideTemperature != nil {
+		data.OutsideTemperature = types.Float64Value(temperatureInUnit(weather.OutsideTemperature.Celsius, weather.OutsideTemperature.Fahrenheit, unit))
+	}
+	data.SolarIntensity = types.Float64Null()
+	if weather.SolarIntensity != nil {
+		data.SolarIntensity = types.Float64Value(weather.SolarIntensity.Percentage)
+	}
+	data.WeatherState = types.StringNull()
+	if weather.WeatherState != nil {
+		data.WeatherState = types.StringValue(weather.WeatherState.Value)
+	}
+}
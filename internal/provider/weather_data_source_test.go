@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWeatherToDataSourceModel(t *testing.T) {
+	weather := &gotado.Weather{
+		OutsideTemperature: &gotado.TemperatureMeasurement{Celsius: -2.5, Fahrenheit: 27.5},
+		SolarIntensity:     &gotado.PercentageMeasurement{Percentage: 12},
+		WeatherState:       &gotado.WeatherMeasurement{Value: "SNOW"},
+	}
+
+	cases := map[string]struct {
+		weather            *gotado.Weather
+		temperatureUnit    types.String
+		homeUnit           gotado.TemperatureUnit
+		outsideTemperature types.Float64
+		solarIntensity     types.Float64
+		weatherState       types.String
+	}{
+		"unit of the home": {
+			weather:            weather,
+			temperatureUnit:    types.StringNull(),
+			homeUnit:           gotado.TemperatureUnitCelsius,
+			outsideTemperature: types.Float64Value(-2.5),
+			solarIntensity:     types.Float64Value(12),
+			weatherState:       types.StringValue("SNOW"),
+		},
+		"fahrenheit home": {
+			weather:            weather,
+			temperatureUnit:    types.StringNull(),
+			homeUnit:           gotado.TemperatureUnitFahrenheit,
+			outsideTemperature: types.Float64Value(27.5),
+			solarIntensity:     types.Float64Value(12),
+			weatherState:       types.StringValue("SNOW"),
+		},
+		"configured unit overrides the home": {
+			weather:            weather,
+			temperatureUnit:    types.StringValue(string(gotado.TemperatureUnitFahrenheit)),
+			homeUnit:           gotado.TemperatureUnitCelsius,
+			outsideTemperature: types.Float64Value(27.5),
+			solarIntensity:     types.Float64Value(12),
+			weatherState:       types.StringValue("SNOW"),
+		},
+		"missing measurements": {
+			weather:            &gotado.Weather{},
+			temperatureUnit:    types.StringNull(),
+			homeUnit:           gotado.TemperatureUnitCelsius,
+			outsideTemperature: types.Float64Null(),
+			solarIntensity:     types.Float64Null(),
+			weatherState:       types.StringNull(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var data WeatherDataSourceModel
+			unit := temperatureUnit(tc.temperatureUnit, &gotado.Home{TemperatureUnit: tc.homeUnit})
+			weatherToDataSourceModel(tc.weather, unit, &data)
+			if !data.OutsideTemperature.Equal(tc.outsideTemperature) {
+				t.Fatalf("Expected: outside temperature %v, got: %v", tc.outsideTemperature, data.OutsideTemperature)
+			}
+			if !data.SolarIntensity.Equal(tc.solarIntensity) {
+				t.Fatalf("Expected: solar intensity %v, got: %v", tc.solarIntensity, data.SolarIntensity)
+			}
+			if !data.WeatherState.Equal(tc.weatherState) {
+				t.Fatalf("Expected: weather state %v, got: %v", tc.weatherState, data.WeatherState)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ZoneCapabilitiesDataSource{}
+
+func NewZoneCapabilitiesDataSource() datasource.DataSource {
+	return &ZoneCapabilitiesDataSource{}
+}
+
+type ZoneCapabilitiesDataSource struct {
+	client *gotado.Tado
+}
+
+type ZoneCapabilitiesDataSourceModel struct {
+	ID                types.Int64    `tfsdk:"id"`
+	Zone              types.String   `tfsdk:"zone"`
+	Home              types.String   `tfsdk:"home"`
+	HomeID            types.Int64    `tfsdk:"home_id"`
+	Type              types.String   `tfsdk:"type"`
+	CanSetTemperature types.Bool     `tfsdk:"can_set_temperature"`
+	CelsiusMin        types.Float64  `tfsdk:"celsius_min"`
+	CelsiusMax        types.Float64  `tfsdk:"celsius_max"`
+	CelsiusStep       types.Float64  `tfsdk:"celsius_step"`
+	FahrenheitMin     types.Float64  `tfsdk:"fahrenheit_min"`
+	FahrenheitMax     types.Float64  `tfsdk:"fahrenheit_max"`
+	FahrenheitStep    types.Float64  `tfsdk:"fahrenheit_step"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (*ZoneCapabilitiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_capabilities"
+}
+
+func (ZoneCapabilitiesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The capabilities of a tado zone, such as the range of temperatures that can be set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Zone ID. Either `id` or `zone` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Name of the zone. Either `id` or `zone` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("id")),
+				},
+			},
+			"home": schema.StringAttribute{
+				MarkdownDescription: "The name of the home this zone belongs to. Either `home_id` or `home` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("home_id")),
+				},
+			},
+			"home_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the home this zone belongs to. Either `home_id` or `home` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Zone type. Can be either 'HEATING' or 'HOT_WATER'.",
+				Computed:            true,
+			},
+			"can_set_temperature": schema.BoolAttribute{
+				MarkdownDescription: "Whether a temperature can be set in the zone. Null if not reported by tado.",
+				Computed:            true,
+			},
+			"celsius_min": schema.Float64Attribute{
+				MarkdownDescription: "Minimum temperature in Celsius that can be set in the zone.",
+				Computed:            true,
+			},
+			"celsius_max": schema.Float64Attribute{
+				MarkdownDescription: "Maximum temperature in Celsius that can be set in the zone.",
+				Computed:            true,
+			},
+			"celsius_step": schema.Float64Attribute{
+				MarkdownDescription: "Step size in Celsius in which temperatures can be set in the zone.",
+				Computed:            true,
+			},
+			"fahrenheit_min": schema.Float64Attribute{
+				MarkdownDescription: "Minimum temperature in Fahrenheit that can be set in the zone.",
+				Computed:            true,
+			},
+			"fahrenheit_max": schema.Float64Attribute{
+				MarkdownDescription: "Maximum temperature in Fahrenheit that can be set in the zone.",
+				Computed:            true,
+			},
+			"fahrenheit_step": schema.Float64Attribute{
+				MarkdownDescription: "Step size in Fahrenheit in which temperatures can be set in the zone.",
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *ZoneCapabilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*tadoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *tadoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.newClient(ctx, &resp.Diagnostics)
+}
+
+func (d ZoneCapabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneCapabilitiesDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	me, err := d.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, data.HomeID, data.Home)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, diags := getZone(ctx, home, data.ID, data.Zone)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkName(path.Root("home"), "Home", data.Home, home.Name)...)
+	resp.Diagnostics.Append(checkName(path.Root("zone"), "Zone", data.Zone, zone.Name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	capabilities, err := zone.GetCapabilities(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get capabilities of zone '%s': %v", zone.Name, err))
+		return
+	}
+
+	data.ID = types.Int64Value(int64(zone.ID))
+	data.Zone = types.StringValue(zone.Name)
+	data.Home = types.StringValue(home.Name)
+	data.HomeID = types.Int64Value(int64(home.ID))
+	data.Type = types.StringValue(string(capabilities.Type))
+	data.CanSetTemperature = types.BoolNull()
+	if capabilities.CanSetTemperature != nil {
+		data.CanSetTemperature = types.BoolValue(*capabilities.CanSetTemperature)
+	}
+	var celsius, fahrenheit *gotado.ZoneCapabilitiesTemperatureValues
+	if capabilities.Temperatures != nil {
+		celsius, fahrenheit = capabilities.Temperatures.Celsius, capabilities.Temperatures.Fahrenheit
+	}
+	data.CelsiusMin, data.CelsiusMax, data.CelsiusStep = temperatureCapabilitiesToValues(celsius)
+	data.FahrenheitMin, data.FahrenheitMax, data.FahrenheitStep = temperatureCapabilitiesToValues(fahrenheit)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// temperatureCapabilitiesToValues converts temperature capabilities to min,
+// max and step values. All values are Null if the capabilities are nil.
+func temperatureCapabilitiesToValues(values *gotado.ZoneCapabilitiesTemperatureValues) (types.Float64, types.Float64, types.Float64) {
+	if values == nil {
+		return types.Float64Null(), types.Float64Null(), types.Float64Null()
+	}
+	return types.Float64Value(float64(values.Min)), types.Float64Value(float64(values.Max)), types.Float64Value(roundTemperatureStep(values.Step))
+}
+
+// roundTemperatureStep converts a temperature step to float64, removing the
+// noise of the float32 representation (e.g. 0.1 instead of 0.100000001).
+func roundTemperatureStep(step float32) float64 {
+	return math.Round(float64(step)*1000) / 1000
+}
+
+// checkTemperatureCapabilities checks if a temperature can be set in a zone
+// with the given temperature capabilities. The temperature must be between
+// the minimum and maximum and be a multiple of the step size.
+func checkTemperatureCapabilities(temperature float64, values *gotado.ZoneCapabilitiesTemperatureValues) error {
+	if values == nil {
+		return nil
+	}
+
+	min, max := float64(values.Min), float64(values.Max)
+	if temperature < min || temperature > max {
+		return fmt.Errorf("temperature %g is outside of the supported range %g - %g", temperature, min, max)
+	}
+
+	step := roundTemperatureStep(values.Step)
+	if step <= 0 {
+		return nil
+	}
+	steps := (temperature - min) / step
+	if math.Abs(steps-math.Round(steps)) > 1e-6 {
+		return fmt.Errorf("temperature %g can only be set in steps of %g", temperature, step)
+	}
+
+	return nil
+}
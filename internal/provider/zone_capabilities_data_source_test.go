@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/gonzolino/gotado/v2"
+)
+
+func TestCheckTemperatureCapabilities(t *testing.T) {
+	celsius := &gotado.ZoneCapabilitiesTemperatureValues{Min: 5, Max: 25, Step: 0.1}
+	fahrenheit := &gotado.ZoneCapabilitiesTemperatureValues{Min: 41, Max: 77, Step: 1}
+	cases := []struct {
+		temperature float64
+		values      *gotado.ZoneCapabilitiesTemperatureValues
+		valid       bool
+	}{
+		{temperature: 20, values: celsius, valid: true},
+		{temperature: 20.5, values: celsius, valid: true},
+		{temperature: 20.1, values: celsius, valid: true},
+		{temperature: 5, values: celsius, valid: true},
+		{temperature: 25, values: celsius, valid: true},
+		// below minimum
+		{temperature: 4.5, values: celsius, valid: false},
+		// above maximum
+		{temperature: 25.5, values: celsius, valid: false},
+		// not a multiple of the step size
+		{temperature: 20.05, values: celsius, valid: false},
+		{temperature: 68, values: fahrenheit, valid: true},
+		{temperature: 68.5, values: fahrenheit, valid: false},
+		// no capabilities reported
+		{temperature: 100, values: nil, valid: true},
+	}
+
+	for _, c := range cases {
+		err := checkTemperatureCapabilities(c.temperature, c.values)
+		if c.valid && err != nil {
+			t.Errorf("Expected temperature %g to be valid, got: %v", c.temperature, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("Expected temperature %g to be invalid, got no error", c.temperature)
+		}
+	}
+}
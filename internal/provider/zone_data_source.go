@@ -5,8 +5,12 @@ import (
 	"fmt"
 
 	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -22,35 +26,51 @@ type ZoneDataSource struct {
 }
 
 type ZoneDataSourceModel struct {
-	ID                         types.Int64  `tfsdk:"id"`
-	Name                       types.String `tfsdk:"name"`
-	Home                       types.String `tfsdk:"home"`
-	Type                       types.String `tfsdk:"type"`
-	EarlyStart                 types.Bool   `tfsdk:"early_start"`
-	DazzleModeEnabled          types.Bool   `tfsdk:"dazzle_mode_enabled"`
-	OpenWindowDetectionEnabled types.Bool   `tfsdk:"open_window_detection_enabled"`
+	ID                         types.Int64    `tfsdk:"id"`
+	Name                       types.String   `tfsdk:"name"`
+	Home                       types.String   `tfsdk:"home"`
+	HomeID                     types.Int64    `tfsdk:"home_id"`
+	Type                       types.String   `tfsdk:"type"`
+	EarlyStart                 types.Bool     `tfsdk:"early_start"`
+	DazzleModeEnabled          types.Bool     `tfsdk:"dazzle_mode_enabled"`
+	OpenWindowDetectionEnabled types.Bool     `tfsdk:"open_window_detection_enabled"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (*ZoneDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_zone"
 }
 
-func (ZoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+func (ZoneDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "A tado zone corresponds to a room in your home. It can contain several tado devices and has its own schedule and configuration.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
-				MarkdownDescription: "Zone ID.",
+				MarkdownDescription: "Zone ID. Either `id` or `name` must be set.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the zone.",
-				Required:            true,
+				MarkdownDescription: "Name of the zone. Either `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("id")),
+				},
 			},
 			"home": schema.StringAttribute{
-				MarkdownDescription: "The name of the home this zone belongs to.",
-				Required:            true,
+				MarkdownDescription: "The name of the home this zone belongs to. Either `home_id` or `home` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("home_id")),
+				},
+			},
+			"home_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the home this zone belongs to. Either `home_id` or `home` must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "Zone type. Can be either 'Heating' or 'Hot Water'.",
@@ -69,6 +89,10 @@ func (ZoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp
 				Computed:            true,
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
 	}
 }
 
@@ -89,7 +113,7 @@ func (d *ZoneDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	d.client = gotado.NewWithTokenRefreshCallback(ctx, data.config, data.token, createTokenUpdateCallback(data.tokenPath, &resp.Diagnostics))
+	d.client = data.newClient(ctx, &resp.Diagnostics)
 }
 
 func (d ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -102,23 +126,40 @@ func (d ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	me, err := d.client.Me(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
 		return
 	}
 
-	homeName := data.Home.ValueString()
-	home, err := me.GetHome(ctx, homeName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get home '%s': %v", homeName, err))
+	home, diags := getHome(ctx, me, data.HomeID, data.Home)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	zoneName := data.Name.ValueString()
-	zone, err := home.GetZone(ctx, zoneName)
-	if err != nil {
-		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get zone '%s': %v", zoneName, err))
+	zone, diags := getZone(ctx, home, data.ID, data.Name)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkName(path.Root("home"), "Home", data.Home, home.Name)...)
+	resp.Diagnostics.Append(checkName(path.Root("name"), "Zone", data.Name, zone.Name)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -131,6 +172,7 @@ func (d ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	data.ID = types.Int64Value(int64(zone.ID))
 	data.Name = types.StringValue(zone.Name)
 	data.Home = types.StringValue(home.Name)
+	data.HomeID = types.Int64Value(int64(home.ID))
 	data.Type = types.StringValue(string(zone.Type))
 	data.EarlyStart = types.BoolValue(earlyStart)
 	data.DazzleModeEnabled = types.BoolValue(zone.DazzleMode.Enabled)
@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ZoneStateDataSource{}
+
+func NewZoneStateDataSource() datasource.DataSource {
+	return &ZoneStateDataSource{}
+}
+
+type ZoneStateDataSource struct {
+	client *gotado.Tado
+}
+
+type ZoneStateDataSourceModel struct {
+	ID                     types.Int64    `tfsdk:"id"`
+	Zone                   types.String   `tfsdk:"zone"`
+	Home                   types.String   `tfsdk:"home"`
+	HomeID                 types.Int64    `tfsdk:"home_id"`
+	TemperatureUnit        types.String   `tfsdk:"temperature_unit"`
+	InsideTemperature      types.Float64  `tfsdk:"inside_temperature"`
+	Humidity               types.Float64  `tfsdk:"humidity"`
+	HeatingPower           types.Float64  `tfsdk:"heating_power"`
+	Heating                types.Bool     `tfsdk:"heating"`
+	Temperature            types.Float64  `tfsdk:"temperature"`
+	OverlayActive          types.Bool     `tfsdk:"overlay_active"`
+	OverlayTerminationType types.String   `tfsdk:"overlay_termination_type"`
+	OverlayExpiry          types.String   `tfsdk:"overlay_expiry"`
+	OpenWindow             types.Bool     `tfsdk:"open_window"`
+	NextChangeStart        types.String   `tfsdk:"next_change_start"`
+	NextChangeHeating      types.Bool     `tfsdk:"next_change_heating"`
+	NextChangeTemperature  types.Float64  `tfsdk:"next_change_temperature"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (*ZoneStateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_state"
+}
+
+func (ZoneStateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The current state of a tado zone, such as the measured inside temperature and humidity, the active setting and upcoming schedule changes.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Zone ID. Either `id` or `zone` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Name of the zone. Either `id` or `zone` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("id")),
+				},
+			},
+			"home": schema.StringAttribute{
+				MarkdownDescription: "The name of the home this zone belongs to. Either `home_id` or `home` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("home_id")),
+				},
+			},
+			"home_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the home this zone belongs to. Either `home_id` or `home` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"temperature_unit": schema.StringAttribute{
+				MarkdownDescription: "Unit of all temperatures of the zone state. Either 'CELSIUS' or 'FAHRENHEIT'. Defaults to the temperature unit of the home.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(temperatureUnits...),
+				},
+			},
+			"inside_temperature": schema.Float64Attribute{
+				MarkdownDescription: "Temperature measured inside the zone, in the unit given by `temperature_unit`.",
+				Computed:            true,
+			},
+			"humidity": schema.Float64Attribute{
+				MarkdownDescription: "Humidity measured inside the zone in percent.",
+				Computed:            true,
+			},
+			"heating_power": schema.Float64Attribute{
+				MarkdownDescription: "Current heating power of the zone in percent.",
+				Computed:            true,
+			},
+			"heating": schema.BoolAttribute{
+				MarkdownDescription: "Whether heating is turned on by the active setting.",
+				Computed:            true,
+			},
+			"temperature": schema.Float64Attribute{
+				MarkdownDescription: "The temperature the zone is heated to by the active setting, in the unit given by `temperature_unit`. Null if heating is turned off.",
+				Computed:            true,
+			},
+			"overlay_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the schedule is currently overridden by manual control.",
+				Computed:            true,
+			},
+			"overlay_termination_type": schema.StringAttribute{
+				MarkdownDescription: "When the active manual control ends. Can be one of 'MANUAL' (until ended by the user), 'TIMER' (after a fixed duration) or 'TADO_MODE' (until the next automatic change). Null if no overlay is active.",
+				Computed:            true,
+			},
+			"overlay_expiry": schema.StringAttribute{
+				MarkdownDescription: "When the active manual control is expected to end, in RFC 3339 format. Null if no overlay is active or it does not expire.",
+				Computed:            true,
+			},
+			"open_window": schema.BoolAttribute{
+				MarkdownDescription: "Whether an open window has been detected in the zone.",
+				Computed:            true,
+			},
+			"next_change_start": schema.StringAttribute{
+				MarkdownDescription: "When the next scheduled change takes place, in RFC 3339 format. Null if no change is scheduled.",
+				Computed:            true,
+			},
+			"next_change_heating": schema.BoolAttribute{
+				MarkdownDescription: "Whether heating is turned on by the next scheduled change.",
+				Computed:            true,
+			},
+			"next_change_temperature": schema.Float64Attribute{
+				MarkdownDescription: "The temperature the zone is heated to by the next scheduled change, in the unit given by `temperature_unit`. Null if heating is turned off.",
+				Computed:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (d *ZoneStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*tadoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *tadoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.newClient(ctx, &resp.Diagnostics)
+}
+
+func (d ZoneStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneStateDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	me, err := d.client.Me(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to authenticate with Tado: %v", err))
+		return
+	}
+
+	home, diags := getHome(ctx, me, data.HomeID, data.Home)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, diags := getZone(ctx, home, data.ID, data.Zone)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkName(path.Root("home"), "Home", data.Home, home.Name)...)
+	resp.Diagnostics.Append(checkName(path.Root("zone"), "Zone", data.Zone, zone.Name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := zone.GetState(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Tado API Error", fmt.Sprintf("Unable to get state of zone '%s': %v", zone.Name, err))
+		return
+	}
+
+	data.ID = types.Int64Value(int64(zone.ID))
+	data.Zone = types.StringValue(zone.Name)
+	data.Home = types.StringValue(home.Name)
+	data.HomeID = types.Int64Value(int64(home.ID))
+	unit := temperatureUnit(data.TemperatureUnit, home)
+	data.TemperatureUnit = types.StringValue(string(unit))
+	zoneStateToDataSourceModel(state, unit, &data)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// zoneStateToDataSourceModel copies the measurements and settings of a zone
+// state into the data source model, with temperatures in the given unit.
+// Values which are not reported by tado are set to Null.
+func zoneStateToDataSourceModel(state *gotado.ZoneState, unit gotado.TemperatureUnit, data *ZoneStateDataSourceModel) {
+	data.InsideTemperature = types.Float64Null()
+	data.Humidity = types.Float64Null()
+	if sensors := state.SensorDataPoints; sensors != nil {
+		if sensors.InsideTemperature != nil {
+			data.InsideTemperature = types.Float64Value(temperatureInUnit(sensors.InsideTemperature.Celsius, sensors.InsideTemperature.Fahrenheit, unit))
+		}
+		if sensors.Humidity != nil {
+			data.Humidity = types.Float64Value(sensors.Humidity.Percentage)
+		}
+	}
+
+	data.HeatingPower = types.Float64Null()
+	if activity := state.ActivityDataPoints; activity != nil && activity.HeatingPower != nil {
+		data.HeatingPower = types.Float64Value(activity.HeatingPower.Percentage)
+	}
+
+	data.Heating, data.Temperature = zoneSettingToValues(&state.Setting, unit)
+
+	data.OverlayActive = types.BoolValue(state.Overlay != nil)
+	data.OverlayTerminationType = types.StringNull()
+	data.OverlayExpiry = types.StringNull()
+	if state.Overlay != nil && state.Overlay.Termination != nil {
+		termination := state.Overlay.Termination
+		data.OverlayTerminationType = types.StringValue(string(termination.Type))
+		if termination.ProjectedExpiry != nil {
+			data.OverlayExpiry = types.StringValue(*termination.ProjectedExpiry)
+		} else if termination.Expiry != "" {
+			data.OverlayExpiry = types.StringValue(termination.Expiry)
+		}
+	}
+
+	data.OpenWindow = types.BoolValue(state.OpenWindowDetected || state.OpenWindow != nil)
+
+	data.NextChangeStart = types.StringNull()
+	data.NextChangeHeating = types.BoolNull()
+	data.NextChangeTemperature = types.Float64Null()
+	if next := state.NextScheduledChange; next != nil {
+		data.NextChangeStart = types.StringValue(next.Start.Format(time.RFC3339))
+		data.NextChangeHeating, data.NextChangeTemperature = zoneSettingToValues(next.Setting, unit)
+	}
+}
+
+// zoneSettingToValues converts a zone setting to values describing whether
+// heating is turned on and to which temperature in the given unit. The
+// temperature is Null if the setting does not carry one.
+func zoneSettingToValues(setting *gotado.ZoneSetting, unit gotado.TemperatureUnit) (types.Bool, types.Float64) {
+	if setting == nil {
+		return types.BoolNull(), types.Float64Null()
+	}
+	temperature := types.Float64Null()
+	if setting.Temperature != nil {
+		temperature = types.Float64Value(settingTemperature(setting.Temperature, unit))
+	}
+	return types.BoolValue(setting.Power == gotado.PowerOn), temperature
+}
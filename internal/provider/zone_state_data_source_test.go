@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gonzolino/gotado/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestZoneStateToDataSourceModel(t *testing.T) {
+	expiry := "2024-01-15T18:00:00Z"
+	heating := gotado.ZoneSetting{Type: gotado.ZoneTypeHeating, Power: gotado.PowerOn, Temperature: &gotado.ZoneSettingTemperature{Celsius: 20.5, Fahrenheit: 68.9}}
+	off := gotado.ZoneSetting{Type: gotado.ZoneTypeHeating, Power: gotado.PowerOff}
+	sensors := &gotado.ZoneStateSensorDataPoints{
+		InsideTemperature: &gotado.TemperatureMeasurement{Celsius: 21.3, Fahrenheit: 70.34},
+		Humidity:          &gotado.PercentageMeasurement{Percentage: 45},
+	}
+
+	cases := map[string]struct {
+		state    *gotado.ZoneState
+		unit     gotado.TemperatureUnit
+		expected ZoneStateDataSourceModel
+	}{
+		"heating following the schedule": {
+			state: &gotado.ZoneState{
+				Setting:            heating,
+				SensorDataPoints:   sensors,
+				ActivityDataPoints: &gotado.ZoneStateActivityDataPoints{HeatingPower: &gotado.PercentageMeasurement{Percentage: 30}},
+				NextScheduledChange: &gotado.ZoneStateNextScheduledChange{
+					Start:   time.Date(2024, 1, 15, 22, 0, 0, 0, time.UTC),
+					Setting: &off,
+				},
+			},
+			unit: gotado.TemperatureUnitCelsius,
+			expected: ZoneStateDataSourceModel{
+				InsideTemperature:      types.Float64Value(21.3),
+				Humidity:               types.Float64Value(45),
+				HeatingPower:           types.Float64Value(30),
+				Heating:                types.BoolValue(true),
+				Temperature:            types.Float64Value(20.5),
+				OverlayActive:          types.BoolValue(false),
+				OverlayTerminationType: types.StringNull(),
+				OverlayExpiry:          types.StringNull(),
+				OpenWindow:             types.BoolValue(false),
+				NextChangeStart:        types.StringValue("2024-01-15T22:00:00Z"),
+				NextChangeHeating:      types.BoolValue(false),
+				NextChangeTemperature:  types.Float64Null(),
+			},
+		},
+		"manual overlay in fahrenheit": {
+			state: &gotado.ZoneState{
+				Setting:          heating,
+				SensorDataPoints: sensors,
+				Overlay: &gotado.ZoneOverlay{
+					Type:        gotado.OverlayTypeManual,
+					Setting:     &heating,
+					Termination: &gotado.ZoneOverlayTermination{Type: gotado.OverlayTypeTimer, ProjectedExpiry: &expiry},
+				},
+				OpenWindowDetected: true,
+			},
+			unit: gotado.TemperatureUnitFahrenheit,
+			expected: ZoneStateDataSourceModel{
+				InsideTemperature:      types.Float64Value(70.34),
+				Humidity:               types.Float64Value(45),
+				HeatingPower:           types.Float64Null(),
+				Heating:                types.BoolValue(true),
+				Temperature:            types.Float64Value(69),
+				OverlayActive:          types.BoolValue(true),
+				OverlayTerminationType: types.StringValue("TIMER"),
+				OverlayExpiry:          types.StringValue(expiry),
+				OpenWindow:             types.BoolValue(true),
+				NextChangeStart:        types.StringNull(),
+				NextChangeHeating:      types.BoolNull(),
+				NextChangeTemperature:  types.Float64Null(),
+			},
+		},
+		"zone turned off without measurements": {
+			state: &gotado.ZoneState{Setting: off},
+			unit:  gotado.TemperatureUnitCelsius,
+			expected: ZoneStateDataSourceModel{
+				InsideTemperature:      types.Float64Null(),
+				Humidity:               types.Float64Null(),
+				HeatingPower:           types.Float64Null(),
+				Heating:                types.BoolValue(false),
+				Temperature:            types.Float64Null(),
+				OverlayActive:          types.BoolValue(false),
+				OverlayTerminationType: types.StringNull(),
+				OverlayExpiry:          types.StringNull(),
+				OpenWindow:             types.BoolValue(false),
+				NextChangeStart:        types.StringNull(),
+				NextChangeHeating:      types.BoolNull(),
+				NextChangeTemperature:  types.Float64Null(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var data ZoneStateDataSourceModel
+			zoneStateToDataSourceModel(tc.state, tc.unit, &data)
+			if !reflect.DeepEqual(data, tc.expected) {
+				t.Fatalf("Expected: %+v, got: %+v", tc.expected, data)
+			}
+		})
+	}
+}